@@ -0,0 +1,163 @@
+package lorca
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"strings"
+)
+
+// bindingReply settles the promise returned by a binding wrapper installed by
+// Bind
+const bindingReply = `(name, seq, result, error) => {
+	const me = window[name];
+	if (error) {
+		me['errors'].get(seq)(error);
+	} else {
+		me['callbacks'].get(seq)(result);
+	}
+	me['callbacks'].delete(seq);
+	me['errors'].delete(seq);
+}`
+
+// watchContexts keeps track of the default execution context of the main
+// frame, which is where Call runs functions. It must be called before the read
+// loop is started.
+func (c *Chrome) watchContexts() {
+	c.On("Runtime.executionContextCreated", func(params json.RawMessage) {
+		res := struct {
+			Context struct {
+				ID      int `json:"id"`
+				AuxData struct {
+					IsDefault bool   `json:"isDefault"`
+					FrameID   string `json:"frameId"`
+				} `json:"auxData"`
+			} `json:"context"`
+		}{}
+		if json.Unmarshal(params, &res) != nil {
+			return
+		}
+		c.Lock()
+		if res.Context.AuxData.IsDefault && res.Context.AuxData.FrameID == c.target {
+			c.context = res.Context.ID
+		}
+		c.Unlock()
+	})
+	c.On("Runtime.executionContextDestroyed", func(params json.RawMessage) {
+		res := struct {
+			ID int `json:"executionContextId"`
+		}{}
+		if json.Unmarshal(params, &res) != nil {
+			return
+		}
+		c.Lock()
+		if c.context == res.ID {
+			c.context = 0
+		}
+		c.Unlock()
+	})
+	c.On("Runtime.executionContextsCleared", func(params json.RawMessage) {
+		c.Lock()
+		c.context = 0
+		c.Unlock()
+	})
+}
+
+// Call calls a JavaScript function with the given arguments and returns its
+// result. fn is a function declaration like "(a, b) => a + b". Arguments are
+// passed as JSON values rather than spliced into the source, so they need no
+// quoting or escaping. If the function returns a promise, Call waits for it.
+func (c *Chrome) Call(fn string, args ...interface{}) (json.RawMessage, error) {
+	return c.CallContext(context.Background(), fn, args...)
+}
+
+// CallContext is like Call, but gives up waiting for the result once ctx is
+// done
+func (c *Chrome) CallContext(ctx context.Context, fn string, args ...interface{}) (json.RawMessage, error) {
+	raw, err := c.callGlobal(ctx, fn, h{"returnByValue": true}, args...)
+	if err != nil {
+		return nil, err
+	}
+	return evalValue(raw)
+}
+
+// callGlobal calls fn in the default execution context of the main frame
+func (c *Chrome) callGlobal(ctx context.Context, fn string, params h, args ...interface{}) (json.RawMessage, error) {
+	c.Lock()
+	id := c.context
+	c.Unlock()
+	if id != 0 {
+		p := h{"executionContextId": id}
+		for k, v := range params {
+			p[k] = v
+		}
+		raw, err := c.callFunction(ctx, fn, p, args...)
+		// The context may have been destroyed by a navigation in the meantime
+		if err == nil || !strings.Contains(err.Error(), "Cannot find context") {
+			return raw, err
+		}
+	}
+	// No known context, call the function on the global object instead
+	raw, err := c.SendRaw(ctx, "Runtime.evaluate", h{"expression": "globalThis"})
+	if err != nil {
+		return nil, err
+	}
+	global, err := evalObject(raw)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Send("Runtime.releaseObject", h{"objectId": global.ObjectID})
+	params["objectId"] = global.ObjectID
+	return c.callFunction(ctx, fn, params, args...)
+}
+
+// callFunction sends Runtime.callFunctionOn with the given target and options,
+// an executionContextId or an objectId, and returns the raw response
+func (c *Chrome) callFunction(ctx context.Context, fn string, params h, args ...interface{}) (json.RawMessage, error) {
+	arguments := []h{}
+	for _, arg := range args {
+		a, err := callArgument(arg)
+		if err != nil {
+			return nil, err
+		}
+		arguments = append(arguments, a)
+	}
+	params["functionDeclaration"] = fn
+	params["arguments"] = arguments
+	params["awaitPromise"] = true
+	return c.SendRaw(ctx, "Runtime.callFunctionOn", params)
+}
+
+// callArgument converts a Go value into a Runtime.CallArgument. Numbers that
+// have no JSON representation are passed as unserializable values, handles
+// are passed by reference.
+func callArgument(v interface{}) (h, error) {
+	f, ok := 0.0, false
+	switch n := v.(type) {
+	case *JSHandle:
+		return n.callArgument()
+	case *Element:
+		return n.callArgument()
+	case float64:
+		f, ok = n, true
+	case float32:
+		f, ok = float64(n), true
+	}
+	if ok {
+		switch {
+		case math.IsNaN(f):
+			return h{"unserializableValue": "NaN"}, nil
+		case math.IsInf(f, 1):
+			return h{"unserializableValue": "Infinity"}, nil
+		case math.IsInf(f, -1):
+			return h{"unserializableValue": "-Infinity"}, nil
+		case f == 0 && math.Signbit(f):
+			return h{"unserializableValue": "-0"}, nil
+		}
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return h{"value": json.RawMessage(b)}, nil
+}
@@ -0,0 +1,57 @@
+package lorca
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestCallArgument(t *testing.T) {
+	for _, test := range []struct {
+		Arg    interface{}
+		Result string
+	}{
+		{Arg: 42, Result: `{"value":42}`},
+		{Arg: `a"b`, Result: `{"value":"a\"b"}`},
+		{Arg: []int{1, 2}, Result: `{"value":[1,2]}`},
+		{Arg: nil, Result: `{"value":null}`},
+		{Arg: math.NaN(), Result: `{"unserializableValue":"NaN"}`},
+		{Arg: math.Inf(1), Result: `{"unserializableValue":"Infinity"}`},
+		{Arg: float32(math.Inf(-1)), Result: `{"unserializableValue":"-Infinity"}`},
+		{Arg: math.Copysign(0, -1), Result: `{"unserializableValue":"-0"}`},
+	} {
+		a, err := callArgument(test.Arg)
+		if err != nil {
+			t.Fatal(test.Arg, err)
+		}
+		if b, _ := json.Marshal(a); string(b) != test.Result {
+			t.Fatal(test.Arg, string(b), test.Result)
+		}
+	}
+	if _, err := callArgument(func() {}); err == nil {
+		t.Fatal("functions can not be passed")
+	}
+}
+
+func TestReplayCall(t *testing.T) {
+	c := replay(t, "testdata/call.jsonl")
+	defer c.Kill()
+
+	for _, test := range []struct {
+		Fn     string
+		Args   []interface{}
+		Result string
+	}{
+		{Fn: `(a, b) => a + b`, Args: []interface{}{2, 3}, Result: `5`},
+		{Fn: `s => s + '!'`, Args: []interface{}{"it's \"quoted\""}, Result: `"it's \"quoted\"!"`},
+		{Fn: `(x, o) => Number.isNaN(x) && o.n`, Args: []interface{}{math.NaN(), map[string][]int{"n": {1, 2}}}, Result: `[1,2]`},
+	} {
+		result, err := c.Call(test.Fn, test.Args...)
+		if err != nil {
+			t.Fatal(test.Fn, err)
+		}
+		if string(result) != test.Result {
+			t.Fatal(test.Fn, string(result), test.Result)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+// Package cdp provides typed bindings for the Chrome DevTools Protocol. Each
+// protocol domain is a small struct wrapping a Client, which is normally a
+// *lorca.Chrome:
+//
+//	page := cdp.Page{Client: chrome}
+//	res, err := page.Navigate(ctx, &cdp.PageNavigateParams{URL: "https://example.com"})
+//	off := page.OnLoadEventFired(func(e *cdp.PageLoadEventFiredEvent) { ... })
+//
+// Optional boolean and number fields are pointers, so that false and 0 can be
+// sent, e.g. Landscape: cdp.Bool(false). Bool, Int and Float64 make them.
+//
+// The bindings are generated from the protocol schema vendored in the
+// protocol directory. To update them, replace the schema files and run
+// "go generate".
+package cdp
+
+//go:generate go run -tags generate gen.go
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+)
+
+// Client sends protocol methods to a browser page and subscribes to its
+// events. *lorca.Chrome implements it. SendRaw must return the responses as
+// they are, see lorca.Chrome.SendRaw.
+type Client interface {
+	SendRaw(ctx context.Context, method string, params map[string]interface{}) (json.RawMessage, error)
+	On(method string, handler func(params json.RawMessage)) func()
+}
+
+// call sends a method with the typed params and decodes the response into
+// result, if it is not nil
+func call(ctx context.Context, c Client, method string, params, result interface{}) error {
+	var args map[string]interface{}
+	if params != nil {
+		b, err := json.Marshal(params)
+		if err != nil {
+			return err
+		}
+		// Keep numbers as they are, large integers must not become floats
+		d := json.NewDecoder(bytes.NewReader(b))
+		d.UseNumber()
+		if err := d.Decode(&args); err != nil {
+			return err
+		}
+	}
+	res, err := c.SendRaw(ctx, method, args)
+	if err != nil || result == nil {
+		return err
+	}
+	return json.Unmarshal(res, result)
+}
+
+// Bool returns a pointer to v, for optional boolean parameters
+func Bool(v bool) *bool { return &v }
+
+// Int returns a pointer to v, for optional integer parameters
+func Int(v int) *int { return &v }
+
+// Float64 returns a pointer to v, for optional number parameters
+func Float64(v float64) *float64 { return &v }
@@ -0,0 +1,75 @@
+package cdp_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/kjk/lorca"
+	"github.com/kjk/lorca/cdp"
+)
+
+var _ cdp.Client = (*lorca.Chrome)(nil)
+
+type fakeClient struct {
+	method string
+	params map[string]interface{}
+	result string
+}
+
+func (c *fakeClient) SendRaw(ctx context.Context, method string, params map[string]interface{}) (json.RawMessage, error) {
+	c.method, c.params = method, params
+	return json.RawMessage(c.result), nil
+}
+
+func (c *fakeClient) On(method string, handler func(json.RawMessage)) func() {
+	handler(json.RawMessage(`{"timestamp": 12.5}`))
+	return func() {}
+}
+
+func TestCommand(t *testing.T) {
+	c := &fakeClient{result: `{"frameId":"F1","loaderId":"L1"}`}
+	res, err := cdp.Page{Client: c}.Navigate(context.Background(), &cdp.PageNavigateParams{
+		URL: "https://example.com",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.method != "Page.navigate" || len(c.params) != 1 || c.params["url"] != "https://example.com" {
+		t.Fatal(c.method, c.params)
+	}
+	if res.FrameID != "F1" || res.LoaderID != "L1" || res.ErrorText != "" {
+		t.Fatal(res)
+	}
+
+	c = &fakeClient{}
+	if err := (cdp.Page{Client: c}).Enable(context.Background(), nil); err != nil {
+		t.Fatal(err)
+	}
+	if c.method != "Page.enable" || c.params != nil {
+		t.Fatal(c.method, c.params)
+	}
+}
+
+func TestOptionalZero(t *testing.T) {
+	c := &fakeClient{result: `{"data":""}`}
+	if _, err := (cdp.Page{Client: c}).PrintToPDF(context.Background(), &cdp.PagePrintToPDFParams{
+		MarginTop: cdp.Float64(0),
+		Landscape: cdp.Bool(false),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if len(c.params) != 2 || c.params["landscape"] != false || c.params["marginTop"] != json.Number("0") {
+		t.Fatal(c.params)
+	}
+}
+
+func TestEvent(t *testing.T) {
+	timestamp := cdp.NetworkMonotonicTime(0)
+	cdp.Page{Client: &fakeClient{}}.OnLoadEventFired(func(e *cdp.PageLoadEventFiredEvent) {
+		timestamp = e.Timestamp
+	})
+	if timestamp != 12.5 {
+		t.Fatal(timestamp)
+	}
+}
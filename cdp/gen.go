@@ -0,0 +1,347 @@
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// This program generates typed bindings for every domain of the vendored
+// DevTools protocol schema, one Go file per domain. Run it with
+// "go generate" from the cdp directory.
+
+type schema struct {
+	Domains []domain `json:"domains"`
+}
+
+type domain struct {
+	Domain       string    `json:"domain"`
+	Description  string    `json:"description"`
+	Experimental bool      `json:"experimental"`
+	Deprecated   bool      `json:"deprecated"`
+	Types        []typ     `json:"types"`
+	Commands     []command `json:"commands"`
+	Events       []command `json:"events"`
+}
+
+// typ is either a named type, a property or an array item
+type typ struct {
+	ID           string   `json:"id"`
+	Name         string   `json:"name"`
+	Description  string   `json:"description"`
+	Type         string   `json:"type"`
+	Ref          string   `json:"$ref"`
+	Items        *typ     `json:"items"`
+	Enum         []string `json:"enum"`
+	Properties   []typ    `json:"properties"`
+	Optional     bool     `json:"optional"`
+	Experimental bool     `json:"experimental"`
+	Deprecated   bool     `json:"deprecated"`
+}
+
+type command struct {
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	Experimental bool   `json:"experimental"`
+	Deprecated   bool   `json:"deprecated"`
+	Redirect     string `json:"redirect"`
+	Parameters   []typ  `json:"parameters"`
+	Returns      []typ  `json:"returns"`
+}
+
+var initialisms = map[string]bool{
+	"api": true, "cpu": true, "css": true, "dns": true, "dom": true, "gpu": true,
+	"guid": true, "html": true, "http": true, "https": true, "id": true,
+	"ids": true, "ip": true, "js": true, "json": true, "pdf": true, "sql": true,
+	"ssl": true, "svg": true, "tls": true, "uri": true, "url": true, "urls": true,
+	"uuid": true, "xml": true,
+}
+
+// exported converts a protocol name like "frameId" or "URLPattern" into an
+// exported Go identifier like "FrameID" or "URLPattern"
+func exported(name string) string {
+	words := []string{}
+	runes := []rune(name)
+	start := 0
+	for i := 1; i <= len(runes); i++ {
+		split := i == len(runes) || !unicode.IsLetter(runes[i]) && !unicode.IsDigit(runes[i])
+		if !split && unicode.IsUpper(runes[i]) {
+			prev := runes[i-1]
+			next := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			split = unicode.IsLower(prev) || unicode.IsDigit(prev) || unicode.IsUpper(prev) && next
+		}
+		if split {
+			if w := strings.TrimFunc(string(runes[start:i]), func(r rune) bool {
+				return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+			}); w != "" {
+				words = append(words, w)
+			}
+			start = i
+		}
+	}
+	s := ""
+	for _, w := range words {
+		lw := strings.ToLower(w)
+		if initialisms[lw] {
+			if strings.HasSuffix(lw, "s") && lw != "https" {
+				s += strings.ToUpper(lw[:len(lw)-1]) + "s"
+			} else {
+				s += strings.ToUpper(lw)
+			}
+		} else {
+			s += strings.ToUpper(w[:1]) + w[1:]
+		}
+	}
+	if s == "" || unicode.IsDigit(rune(s[0])) {
+		s = "X" + s
+	}
+	return s
+}
+
+type generator struct {
+	types map[string]typ // all named types by "Domain.Id"
+	buf   bytes.Buffer
+	json  bool // the current file uses encoding/json
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// comment prints a doc comment, the first line is prepended to the
+// description from the schema
+func (g *generator) comment(first, description string, experimental bool) {
+	g.printf("// %s\n", first)
+	if description != "" {
+		g.printf("//\n")
+		for _, line := range strings.Split(strings.TrimSpace(description), "\n") {
+			g.printf("// %s\n", strings.TrimRight(line, " "))
+		}
+	}
+	if experimental {
+		g.printf("//\n// Experimental.\n")
+	}
+}
+
+func qualify(dom, ref string) string {
+	if strings.Contains(ref, ".") {
+		return ref
+	}
+	return dom + "." + ref
+}
+
+func (g *generator) refName(dom, ref string) string {
+	ref = qualify(dom, ref)
+	if _, ok := g.types[ref]; !ok {
+		log.Fatalf("unknown type %s", ref)
+	}
+	parts := strings.SplitN(ref, ".", 2)
+	return parts[0] + exported(parts[1])
+}
+
+// goType returns a Go type of a property. Optional structs and scalars
+// become pointers, so that zero values like false or 0 can be sent.
+func (g *generator) goType(dom string, t typ) string {
+	if t.Ref != "" {
+		name := g.refName(dom, t.Ref)
+		target := g.types[qualify(dom, t.Ref)]
+		if t.Optional && (target.Type == "object" && len(target.Properties) > 0 || isScalar(target.Type)) {
+			return "*" + name
+		}
+		return name
+	}
+	ptr := ""
+	if t.Optional && isScalar(t.Type) {
+		ptr = "*"
+	}
+	switch t.Type {
+	case "string", "binary":
+		return "string"
+	case "integer":
+		return ptr + "int"
+	case "number":
+		return ptr + "float64"
+	case "boolean":
+		return ptr + "bool"
+	case "array":
+		return "[]" + g.goType(dom, *t.Items)
+	case "object":
+		if len(t.Properties) > 0 {
+			return g.structType(dom, t.Properties)
+		}
+		return "map[string]interface{}"
+	}
+	g.json = true
+	return "json.RawMessage"
+}
+
+func (g *generator) structType(dom string, props []typ) string {
+	var b bytes.Buffer
+	b.WriteString("struct {\n")
+	for _, p := range props {
+		doc := p.Description
+		if len(p.Enum) > 0 {
+			doc = strings.TrimSpace(doc + "\nValues: " + strings.Join(quoteAll(p.Enum), ", ") + ".")
+		}
+		if doc != "" {
+			for _, line := range strings.Split(doc, "\n") {
+				fmt.Fprintf(&b, "// %s\n", strings.TrimRight(line, " "))
+			}
+		}
+		tag := p.Name
+		if p.Optional {
+			tag += ",omitempty"
+		}
+		fmt.Fprintf(&b, "%s %s `json:\"%s\"`\n", exported(p.Name), g.goType(dom, p), tag)
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+// isScalar tells whether a schema type is a boolean or a number, strings are
+// omitted when empty
+func isScalar(typ string) bool {
+	return typ == "integer" || typ == "number" || typ == "boolean"
+}
+
+func quoteAll(values []string) []string {
+	quoted := []string{}
+	for _, v := range values {
+		quoted = append(quoted, fmt.Sprintf("%q", v))
+	}
+	return quoted
+}
+
+func (g *generator) domain(d domain) []byte {
+	g.buf.Reset()
+	g.json = false
+	dom := d.Domain
+
+	g.comment(fmt.Sprintf("%s is a client of the %s domain.", dom, dom), d.Description, d.Experimental)
+	g.printf("type %s struct {\n\tClient Client\n}\n\n", dom)
+
+	for _, t := range d.Types {
+		name := dom + exported(t.ID)
+		g.comment(fmt.Sprintf("%s is %s.%s.", name, dom, t.ID), t.Description, t.Experimental)
+		if len(t.Enum) > 0 {
+			g.printf("type %s string\n\n", name)
+			g.printf("// %s values.\nconst (\n", name)
+			for _, v := range t.Enum {
+				g.printf("\t%s%s %s = %q\n", name, exported(v), name, v)
+			}
+			g.printf(")\n\n")
+			continue
+		}
+		g.printf("type %s %s\n\n", name, g.goType(dom, typ{Type: t.Type, Items: t.Items, Properties: t.Properties}))
+	}
+
+	for _, c := range d.Commands {
+		if c.Deprecated {
+			continue
+		}
+		method := dom + "." + c.Name
+		name := exported(c.Name)
+		params, returns := "", ""
+		if len(c.Parameters) > 0 {
+			params = dom + name + "Params"
+			g.printf("// %s holds the parameters of %s.\n", params, method)
+			g.printf("type %s %s\n\n", params, g.structType(dom, c.Parameters))
+		}
+		if len(c.Returns) > 0 {
+			returns = dom + name + "Returns"
+			g.printf("// %s holds the result of %s.\n", returns, method)
+			g.printf("type %s %s\n\n", returns, g.structType(dom, c.Returns))
+		}
+
+		g.comment(fmt.Sprintf("%s calls %s.", name, method), c.Description, c.Experimental)
+		args, arg := "ctx context.Context", "nil"
+		if params != "" {
+			args += ", params *" + params
+			arg = "params"
+		}
+		if returns == "" {
+			g.printf("func (d %s) %s(%s) error {\n", dom, name, args)
+			g.printf("\treturn call(ctx, d.Client, %q, %s, nil)\n}\n\n", method, arg)
+			continue
+		}
+		g.printf("func (d %s) %s(%s) (*%s, error) {\n", dom, name, args, returns)
+		g.printf("\tres := &%s{}\n", returns)
+		g.printf("\tif err := call(ctx, d.Client, %q, %s, res); err != nil {\n\t\treturn nil, err\n\t}\n", method, arg)
+		g.printf("\treturn res, nil\n}\n\n")
+	}
+
+	for _, e := range d.Events {
+		if e.Deprecated {
+			continue
+		}
+		g.json = true
+		method := dom + "." + e.Name
+		name := dom + exported(e.Name) + "Event"
+		g.comment(fmt.Sprintf("%s is fired as %s.", name, method), e.Description, e.Experimental)
+		g.printf("type %s %s\n\n", name, g.structType(dom, e.Parameters))
+
+		g.printf("// On%s subscribes to %s, the returned function unsubscribes.\n", exported(e.Name), method)
+		g.printf("func (d %s) On%s(f func(*%s)) func() {\n", dom, exported(e.Name), name)
+		g.printf("\treturn d.Client.On(%q, func(params json.RawMessage) {\n", method)
+		g.printf("\t\tev := &%s{}\n\t\tif err := json.Unmarshal(params, ev); err == nil {\n\t\t\tf(ev)\n\t\t}\n\t})\n}\n\n", name)
+	}
+
+	body := g.buf.Bytes()
+	out := &bytes.Buffer{}
+	fmt.Fprintf(out, "// Code generated by cdp/gen.go from the protocol schema. DO NOT EDIT.\n\npackage cdp\n\n")
+	imports := []string{}
+	if bytes.Contains(body, []byte("context.Context")) {
+		imports = append(imports, `"context"`)
+	}
+	if g.json {
+		imports = append(imports, `"encoding/json"`)
+	}
+	if len(imports) > 0 {
+		fmt.Fprintf(out, "import (\n\t%s\n)\n\n", strings.Join(imports, "\n\t"))
+	}
+	out.Write(body)
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		log.Fatalf("%s: %v", dom, err)
+	}
+	return src
+}
+
+func main() {
+	g := &generator{types: map[string]typ{}}
+	domains := []domain{}
+	for _, file := range []string{"browser_protocol.json", "js_protocol.json"} {
+		b, err := ioutil.ReadFile(filepath.Join("protocol", file))
+		if err != nil {
+			log.Fatal(err)
+		}
+		s := schema{}
+		if err := json.Unmarshal(b, &s); err != nil {
+			log.Fatal(file, err)
+		}
+		domains = append(domains, s.Domains...)
+	}
+	sort.Slice(domains, func(i, j int) bool { return domains[i].Domain < domains[j].Domain })
+	for _, d := range domains {
+		for _, t := range d.Types {
+			g.types[d.Domain+"."+t.ID] = t
+		}
+	}
+	for _, d := range domains {
+		file := strings.ToLower(d.Domain) + ".go"
+		if err := ioutil.WriteFile(file, g.domain(d), 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
@@ -2,22 +2,59 @@ package lorca
 
 import (
 	"bufio"
+	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
-	"log"
+	"net/http"
 	"os/exec"
 	"regexp"
+	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
-	"golang.org/x/net/websocket"
+	"github.com/kjk/lorca/cdp"
 )
 
 type h = map[string]interface{}
 
+// ErrBrowserClosed is returned for requests that can not be answered because
+// the connection to the browser has been closed.
+var ErrBrowserClosed = errors.New("browser connection closed")
+
+// CloseReason tells why a browser session has ended.
+type CloseReason int
+
+const (
+	// CloseReasonNone means that the session is still alive
+	CloseReasonNone CloseReason = iota
+	// CloseReasonWindowClosed means that the user has closed the browser window
+	CloseReasonWindowClosed
+	// CloseReasonCrashed means that the browser has exited or dropped the
+	// connection unexpectedly
+	CloseReasonCrashed
+	// CloseReasonKilled means that Kill has been called
+	CloseReasonKilled
+)
+
+func (r CloseReason) String() string {
+	switch r {
+	case CloseReasonNone:
+		return "none"
+	case CloseReasonWindowClosed:
+		return "window closed"
+	case CloseReasonCrashed:
+		return "crashed"
+	case CloseReasonKilled:
+		return "killed"
+	}
+	return fmt.Sprintf("CloseReason(%d)", int(r))
+}
+
 // Result is a struct for the resulting value of the JS expression or an error.
 type result struct {
 	Value json.RawMessage
@@ -26,38 +63,149 @@ type result struct {
 
 type bindingFunc func(args []json.RawMessage) (interface{}, error)
 
+// Event is a protocol event received from the browser, e.g.
+// "Page.loadEventFired" with its parameters.
+type Event struct {
+	Method string
+	Params json.RawMessage
+}
+
+type eventHandler struct {
+	id int
+	f  func(json.RawMessage)
+}
+
 // Msg is a struct for incoming messages (results and async events)
 type msg struct {
-	ID     int             `json:"id"`
-	Result json.RawMessage `json:"result"`
-	Error  json.RawMessage `json:"error"`
-	Method string          `json:"method"`
-	Params json.RawMessage `json:"params"`
+	ID        int             `json:"id"`
+	SessionID string          `json:"sessionId"`
+	Result    json.RawMessage `json:"result"`
+	Error     json.RawMessage `json:"error"`
+	Method    string          `json:"method"`
+	Params    json.RawMessage `json:"params"`
 }
 
 // Chrome represents a chrome process
 type Chrome struct {
 	sync.Mutex
+	// Cmd is the browser process. It is waited for internally, don't call
+	// Cmd.Wait.
 	Cmd      *exec.Cmd
-	ws       *websocket.Conn
+	conn     Transport
 	id       int32
 	target   string
 	session  string
 	window   int
 	pending  map[int]chan result
 	bindings map[string]bindingFunc
+	handlers map[string][]eventHandler
+	lastID   int
+	url      string
+	scripts  []*script
+	crash    crashState
+	console  ConsoleHandler
+	context  int
+	keyboard *Keyboard
+	mouse    *Mouse
+	touch    *Touch
+	fetch    fetchState
+	download downloadState
+	chooser  fileChooser
+	dialog   dialogHandler
+	headless bool
+	reason   CloseReason
+	done     chan struct{}
+	exited   chan struct{}
 }
 
-// NewChromeWithArgs starts chrome process with arguments
-func NewChromeWithArgs(chromeBinary string, args ...string) (*Chrome, error) {
+func newChrome() *Chrome {
 	// The first two IDs are used internally during the initialization
 	c := &Chrome{
 		id:       2,
 		pending:  map[int]chan result{},
 		bindings: map[string]bindingFunc{},
+		handlers: map[string][]eventHandler{},
+		console:  defaultConsole(),
+		done:     make(chan struct{}),
+		exited:   make(chan struct{}),
 	}
+	c.keyboard = &Keyboard{c: c, pressed: map[string]bool{}}
+	c.mouse = &Mouse{c: c}
+	c.touch = &Touch{c: c}
+	return c
+}
+
+// NewChromeWithArgs starts chrome process with arguments
+func NewChromeWithArgs(chromeBinary string, args ...string) (*Chrome, error) {
+	return newChromeWithArgs(nil, chromeBinary, args...)
+}
+
+// RecordChromeWithArgs is like NewChromeWithArgs, but writes all the protocol
+// messages to w, see NewRecorder. The recording can be replayed with
+// NewReplayer, e.g. as a test fixture. It contains everything exchanged with
+// the page, including cookies, typed text and evaluated scripts.
+func RecordChromeWithArgs(w io.Writer, chromeBinary string, args ...string) (*Chrome, error) {
+	return newChromeWithArgs(w, chromeBinary, args...)
+}
+
+func newChromeWithArgs(record io.Writer, chromeBinary string, args ...string) (*Chrome, error) {
+	c := newChrome()
+	c.headless = isHeadless(args)
 
 	c.Cmd = exec.Command(chromeBinary, args...)
+	var err error
+	if contains(args, "--remote-debugging-pipe") {
+		c.conn, err = startPipe(c)
+	} else {
+		c.conn, err = startWebsocket(c)
+	}
+	if err != nil {
+		if c.Cmd.Process != nil {
+			c.Cmd.Process.Kill()
+			c.Cmd.Wait()
+		}
+		return nil, err
+	}
+	go func() {
+		c.Cmd.Wait()
+		close(c.exited)
+	}()
+
+	if record != nil {
+		c.conn = NewRecorder(c.conn, record)
+	}
+
+	// Find target and initialize session
+	c.target, err = c.findTarget()
+	if err != nil {
+		c.Kill()
+		return nil, err
+	}
+
+	c.session, err = c.startSession(c.target)
+	if err != nil {
+		c.Kill()
+		return nil, err
+	}
+	if err := c.init(); err != nil {
+		c.Kill()
+		return nil, err
+	}
+
+	if !contains(args, "--headless") {
+		c.window, err = c.windowForTarget(c.target)
+		if err != nil {
+			c.Kill()
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+// startWebsocket starts the browser process and connects to the websocket
+// address that it prints to stderr
+func startWebsocket(c *Chrome) (Transport, error) {
 	pipe, err := c.Cmd.StderrPipe()
 	if err != nil {
 		return nil, err
@@ -70,61 +218,176 @@ func NewChromeWithArgs(chromeBinary string, args ...string) (*Chrome, error) {
 	re := regexp.MustCompile(`^DevTools listening on (ws://.*?)\r?\n$`)
 	m, err := readUntilMatch(pipe, re)
 	if err != nil {
-		c.Kill()
 		return nil, err
 	}
-	wsURL := m[1]
 
 	// Open a websocket
-	c.ws, err = websocket.Dial(wsURL, "", "http://127.0.0.1")
+	ws, err := dialWebsocket(context.Background(), m[1])
 	if err != nil {
-		c.Kill()
 		return nil, err
 	}
+	return ws, nil
+}
 
-	// Find target and initialize session
-	c.target, err = c.findTarget()
+// Connect attaches to an already running browser by its DevTools websocket
+// URL, e.g. "ws://127.0.0.1:9222/devtools/browser/<id>". The first open page
+// is used, or a new one is created if there are none. Kill on the returned
+// Chrome only disconnects from the browser, it never stops it.
+func Connect(wsURL string) (*Chrome, error) {
+	return connect(context.Background(), wsURL)
+}
+
+func connect(ctx context.Context, wsURL string) (*Chrome, error) {
+	c := newChrome()
+	ws, err := dialWebsocket(ctx, wsURL)
+	if err != nil {
+		return nil, err
+	}
+	c.conn = ws
+	// A stuck browser never answers, closing the connection fails the setup
+	stop := closeOnDone(ctx, ws)
+	err = c.attach()
+	if stop() {
+		err = ctx.Err()
+	}
 	if err != nil {
 		c.Kill()
 		return nil, err
 	}
+	return c, nil
+}
 
-	c.session, err = c.startSession(c.target)
-	if err != nil {
+// attach starts a session with the first page of a connected browser
+func (c *Chrome) attach() error {
+	var err error
+	if c.target, err = c.openTarget(); err != nil {
+		return err
+	}
+	if c.session, err = c.startSession(c.target); err != nil {
+		return err
+	}
+	if err := c.init(); err != nil {
+		return err
+	}
+	// Headless browsers may have no windows, then SetBounds simply fails
+	if win, err := c.windowForTarget(c.target); err == nil {
+		c.window = win
+	}
+	return nil
+}
+
+// NewChromeWithTransport starts a session with a browser over an already
+// connected transport, for example the one returned by NewReplayer. Kill on
+// the returned Chrome only closes the transport.
+func NewChromeWithTransport(t Transport) (*Chrome, error) {
+	c := newChrome()
+	c.conn = t
+	var err error
+	if c.target, err = c.findTarget(); err != nil {
+		c.Kill()
+		return nil, err
+	}
+	if c.session, err = c.startSession(c.target); err != nil {
+		c.Kill()
+		return nil, err
+	}
+	if err := c.init(); err != nil {
 		c.Kill()
 		return nil, err
 	}
+	if win, err := c.windowForTarget(c.target); err == nil {
+		c.window = win
+	}
+	return c, nil
+}
+
+// connectTimeout is how long ConnectHTTP waits for the browser
+const connectTimeout = 30 * time.Second
+
+// ConnectHTTP is like Connect, but discovers the websocket URL through the
+// /json/version endpoint of the given DevTools HTTP address, e.g.
+// "http://127.0.0.1:9222". It gives up after 30 seconds, see
+// ConnectHTTPContext.
+func ConnectHTTP(addr string) (*Chrome, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), connectTimeout)
+	defer cancel()
+	return ConnectHTTPContext(ctx, addr)
+}
+
+// ConnectHTTPContext is like ConnectHTTP, but gives up once ctx is done. ctx
+// only bounds the discovery and the session setup, not the returned Chrome.
+func ConnectHTTPContext(ctx context.Context, addr string) (*Chrome, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(addr, "/")+"/json/version", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s/json/version: %s", addr, resp.Status)
+	}
+	version := struct {
+		WebSocketDebuggerURL string `json:"webSocketDebuggerUrl"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&version); err != nil {
+		return nil, err
+	}
+	if version.WebSocketDebuggerURL == "" {
+		return nil, errors.New("no webSocketDebuggerUrl in " + addr + "/json/version")
+	}
+	return connect(ctx, version.WebSocketDebuggerURL)
+}
+
+func (c *Chrome) write(v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return c.conn.Send(b)
+}
+
+func (c *Chrome) read(m *msg) error {
+	b, err := c.conn.Receive()
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, m)
+}
+
+// init starts reading messages from the browser and enables the protocol
+// domains used by lorca
+func (c *Chrome) init() error {
+	c.watchCrashes()
+	c.watchConsole()
+	c.watchContexts()
+	c.watchFetch()
+	c.watchDownloads()
+	c.watchFileChooser()
+	c.watchDialogs()
 	go c.readLoop()
 	for method, args := range map[string]h{
-		"Page.enable":          nil,
-		"Target.setAutoAttach": {"autoAttach": true, "waitForDebuggerOnStart": false},
-		"Network.enable":       nil,
-		"Runtime.enable":       nil,
-		"Security.enable":      nil,
-		"Performance.enable":   nil,
-		"Log.enable":           nil,
+		"Inspector.enable":               nil,
+		"Page.enable":                    nil,
+		"Page.setLifecycleEventsEnabled": {"enabled": true},
+		"Target.setAutoAttach":           {"autoAttach": true, "waitForDebuggerOnStart": false, "flatten": true},
+		"Network.enable":                 nil,
+		"Runtime.enable":                 nil,
+		"Security.enable":                nil,
+		"Performance.enable":             nil,
+		"Log.enable":                     nil,
 	} {
 		if _, err := c.Send(method, args); err != nil {
-			c.Kill()
-			c.Cmd.Wait()
-			return nil, err
-		}
-	}
-
-	if !contains(args, "--headless") {
-		win, err := c.getWindowForTarget(c.target)
-		if err != nil {
-			c.Kill()
-			return nil, err
+			return err
 		}
-		c.window = win.WindowID
 	}
-
-	return c, nil
+	return nil
 }
 
 func (c *Chrome) findTarget() (string, error) {
-	err := websocket.JSON.Send(c.ws, h{
+	err := c.write(h{
 		"id": 0, "method": "Target.setDiscoverTargets", "params": h{"discover": true},
 	})
 	if err != nil {
@@ -132,7 +395,7 @@ func (c *Chrome) findTarget() (string, error) {
 	}
 	for {
 		m := msg{}
-		if err = websocket.JSON.Receive(c.ws, &m); err != nil {
+		if err = c.read(&m); err != nil {
 			return "", err
 		} else if m.Method == "Target.targetCreated" {
 			target := struct {
@@ -150,28 +413,71 @@ func (c *Chrome) findTarget() (string, error) {
 	}
 }
 
+// openTarget returns the first page of a running browser, or opens a new one
+func (c *Chrome) openTarget() (string, error) {
+	if _, err := c.rawSend("Target.setDiscoverTargets", h{"discover": true}); err != nil {
+		return "", err
+	}
+	result, err := c.rawSend("Target.getTargets", nil)
+	if err != nil {
+		return "", err
+	}
+	targets := struct {
+		Infos []struct {
+			Type string `json:"type"`
+			ID   string `json:"targetId"`
+		} `json:"targetInfos"`
+	}{}
+	if err := json.Unmarshal(result, &targets); err != nil {
+		return "", err
+	}
+	for _, info := range targets.Infos {
+		if info.Type == "page" {
+			return info.ID, nil
+		}
+	}
+	result, err = c.rawSend("Target.createTarget", h{"url": "about:blank"})
+	if err != nil {
+		return "", err
+	}
+	target := struct {
+		ID string `json:"targetId"`
+	}{}
+	err = json.Unmarshal(result, &target)
+	return target.ID, err
+}
+
 func (c *Chrome) startSession(target string) (string, error) {
-	err := websocket.JSON.Send(c.ws, h{
-		"id": 1, "method": "Target.attachToTarget", "params": h{"targetId": target},
-	})
+	result, err := c.rawSend("Target.attachToTarget", h{"targetId": target, "flatten": true})
 	if err != nil {
+		return "", errors.New("Target error: " + err.Error())
+	}
+	session := struct {
+		ID string `json:"sessionId"`
+	}{}
+	if err := json.Unmarshal(result, &session); err != nil {
 		return "", err
 	}
+	return session.ID, nil
+}
+
+// rawSend sends a browser-level method before readLoop is started and waits
+// for its response, skipping all the events in between
+func (c *Chrome) rawSend(method string, params h) (json.RawMessage, error) {
+	id := int(atomic.AddInt32(&c.id, 1))
+	err := c.write(h{"id": id, "method": method, "params": params})
+	if err != nil {
+		return nil, err
+	}
 	for {
 		m := msg{}
-		if err = websocket.JSON.Receive(c.ws, &m); err != nil {
-			return "", err
-		} else if m.ID == 1 {
+		if err = c.read(&m); err != nil {
+			return nil, err
+		} else if m.ID == id {
 			if m.Error != nil {
-				return "", errors.New("Target error: " + string(m.Error))
-			}
-			session := struct {
-				ID string `json:"sessionId"`
-			}{}
-			if err := json.Unmarshal(m.Result, &session); err != nil {
-				return "", err
+				return nil, errors.New(string(m.Error))
 			}
-			return session.ID, nil
+			return m.Result, nil
 		}
 	}
 }
@@ -200,22 +506,17 @@ type Bounds struct {
 	WindowState WindowState `json:"windowState"`
 }
 
-type windowTargetMessage struct {
-	WindowID int    `json:"windowId"`
-	Bounds   Bounds `json:"bounds"`
-}
-
-func (c *Chrome) getWindowForTarget(target string) (windowTargetMessage, error) {
-	var m windowTargetMessage
-	msg, err := c.Send("Browser.getWindowForTarget", h{"targetId": target})
+func (c *Chrome) windowForTarget(target string) (int, error) {
+	res, err := cdp.Browser{Client: c}.GetWindowForTarget(context.Background(), &cdp.BrowserGetWindowForTargetParams{
+		TargetID: cdp.TargetTargetID(target),
+	})
 	if err != nil {
-		return m, err
+		return 0, err
 	}
-	err = json.Unmarshal(msg, &m)
-	return m, err
+	return int(res.WindowID), nil
 }
 
-type targetMessageTemplate struct {
+type targetMessage struct {
 	ID     int    `json:"id"`
 	Method string `json:"method"`
 	Params struct {
@@ -230,106 +531,96 @@ type targetMessageTemplate struct {
 	Error struct {
 		Message string `json:"message"`
 	} `json:"error"`
-	Result json.RawMessage `json:"result"`
 }
 
-type targetMessage struct {
-	targetMessageTemplate
-	Result struct {
-		Result struct {
-			Type        string          `json:"type"`
-			Subtype     string          `json:"subtype"`
-			Description string          `json:"description"`
-			Value       json.RawMessage `json:"value"`
-			ObjectID    string          `json:"objectId"`
-		} `json:"result"`
-		Exception struct {
-			Exception struct {
-				Value json.RawMessage `json:"value"`
-			} `json:"exception"`
-		} `json:"exceptionDetails"`
-	} `json:"result"`
+// evalResult is a response to Runtime.evaluate
+type evalResult struct {
+	Result    remoteObject      `json:"result"`
+	Exception *exceptionDetails `json:"exceptionDetails"`
+}
+
+// evalValue returns the value of a Runtime.evaluate response, or a *JSError
+// if the expression has thrown
+func evalValue(raw json.RawMessage) (json.RawMessage, error) {
+	o, err := evalObject(raw)
+	if err != nil {
+		return nil, err
+	} else if o.Type == "object" && o.Subtype == "error" {
+		return nil, (&exceptionDetails{Exception: &o}).jsError()
+	}
+	return o.Value, nil
+}
+
+// unwrapResult returns the value of the remote object in a response, or the
+// response itself if it has none
+func unwrapResult(raw json.RawMessage) (json.RawMessage, error) {
+	res := evalResult{}
+	if json.Unmarshal(raw, &res) != nil || res.Exception == nil && res.Result.Type == "" {
+		return raw, nil
+	}
+	return evalValue(raw)
+}
+
+// evalObject returns the remote object of a Runtime.evaluate response, or a
+// *JSError if the expression has thrown
+func evalObject(raw json.RawMessage) (remoteObject, error) {
+	res := evalResult{}
+	if err := json.Unmarshal(raw, &res); err != nil {
+		return remoteObject{}, err
+	}
+	if res.Exception != nil {
+		return remoteObject{}, res.Exception.jsError()
+	}
+	return res.Result, nil
 }
 
 func (c *Chrome) readLoop() {
 	for {
-		m := msg{}
-		if err := websocket.JSON.Receive(c.ws, &m); err != nil {
+		b, err := c.conn.Receive()
+		if err != nil {
+			c.shutdown(c.exitReason())
 			return
 		}
+		m := msg{}
+		if err := json.Unmarshal(b, &m); err != nil {
+			continue
+		}
+		// Messages from other sessions, e.g. auto-attached frames and workers
+		if m.SessionID != "" && m.SessionID != c.session {
+			continue
+		}
+		res := targetMessage{}
+		json.Unmarshal(b, &res)
 
-		if m.Method == "Target.receivedMessageFromTarget" {
-			params := struct {
-				SessionID string `json:"sessionId"`
-				Message   string `json:"message"`
+		if m.Method != "" {
+			c.emit(m.Method, m.Params)
+		}
+
+		if m.Method == "Runtime.bindingCalled" {
+			payload := struct {
+				Name string            `json:"name"`
+				Seq  int               `json:"seq"`
+				Args []json.RawMessage `json:"args"`
 			}{}
-			json.Unmarshal(m.Params, &params)
-			if params.SessionID != c.session {
-				continue
-			}
-			res := targetMessage{}
-			json.Unmarshal([]byte(params.Message), &res)
-
-			if res.ID == 0 && res.Method == "Runtime.consoleAPICalled" || res.Method == "Runtime.exceptionThrown" {
-				log.Println(params.Message)
-			} else if res.ID == 0 && res.Method == "Runtime.bindingCalled" {
-				payload := struct {
-					Name string            `json:"name"`
-					Seq  int               `json:"seq"`
-					Args []json.RawMessage `json:"args"`
-				}{}
-				json.Unmarshal([]byte(res.Params.Payload), &payload)
-
-				c.Lock()
-				binding, ok := c.bindings[res.Params.Name]
-				c.Unlock()
-				if ok {
-					jsString := func(v interface{}) string { b, _ := json.Marshal(v); return string(b) }
-					go func() {
-						result, error := "", `""`
-						if r, err := binding(payload.Args); err != nil {
-							error = jsString(err.Error())
-						} else if b, err := json.Marshal(r); err != nil {
-							error = jsString(err.Error())
-						} else {
-							result = string(b)
-						}
-						expr := fmt.Sprintf(`
-							if (%[4]s) {
-								window['%[1]s']['errors'].get(%[2]d)(%[4]s);
-							} else {
-								window['%[1]s']['callbacks'].get(%[2]d)(%[3]s);
-							}
-							window['%[1]s']['callbacks'].delete(%[2]d);
-							window['%[1]s']['errors'].delete(%[2]d);
-							`, payload.Name, payload.Seq, result, error)
-						c.Send("Runtime.evaluate", h{"expression": expr, "contextId": res.Params.ID})
-					}()
-				}
-				continue
-			}
+			json.Unmarshal([]byte(res.Params.Payload), &payload)
 
 			c.Lock()
-			resc, ok := c.pending[res.ID]
-			delete(c.pending, res.ID)
+			binding, ok := c.bindings[res.Params.Name]
 			c.Unlock()
-
-			if !ok {
-				continue
-			}
-
-			if res.Error.Message != "" {
-				resc <- result{Err: errors.New(res.Error.Message)}
-			} else if res.Result.Exception.Exception.Value != nil {
-				resc <- result{Err: errors.New(string(res.Result.Exception.Exception.Value))}
-			} else if res.Result.Result.Type == "object" && res.Result.Result.Subtype == "error" {
-				resc <- result{Err: errors.New(res.Result.Result.Description)}
-			} else if res.Result.Result.Type != "" {
-				resc <- result{Value: res.Result.Result.Value}
-			} else {
-				res := targetMessageTemplate{}
-				json.Unmarshal([]byte(params.Message), &res)
-				resc <- result{Value: res.Result}
+			if ok {
+				go func() {
+					var result interface{}
+					errMsg := ""
+					if r, err := binding(payload.Args); err != nil {
+						errMsg = err.Error()
+					} else if b, err := json.Marshal(r); err != nil {
+						errMsg = err.Error()
+					} else {
+						result = json.RawMessage(b)
+					}
+					c.callFunction(context.Background(), bindingReply, h{"executionContextId": res.Params.ID},
+						payload.Name, payload.Seq, result, errMsg)
+				}()
 			}
 		} else if m.Method == "Target.targetDestroyed" {
 			params := struct {
@@ -337,60 +628,225 @@ func (c *Chrome) readLoop() {
 			}{}
 			json.Unmarshal(m.Params, &params)
 			if params.TargetID == c.target {
+				c.shutdown(CloseReasonWindowClosed)
 				c.Kill()
 				return
 			}
 		}
+		if m.Method != "" {
+			continue
+		}
+
+		c.Lock()
+		resc, ok := c.pending[m.ID]
+		delete(c.pending, m.ID)
+		c.Unlock()
+
+		if !ok {
+			continue
+		}
+
+		if res.Error.Message != "" {
+			resc <- result{Err: errors.New(res.Error.Message)}
+		} else {
+			resc <- result{Value: m.Result}
+		}
 	}
 }
 
 // Send sends a method with a parameters to the browser, waits for response
-// and returns response as json
+// and returns response as json. Responses holding a remote object, like the
+// one of Runtime.evaluate, are unwrapped to the value of the object, and an
+// exception thrown by the page is returned as an error. SendRaw returns the
+// whole response.
 func (c *Chrome) Send(method string, params h) (json.RawMessage, error) {
-	id := atomic.AddInt32(&c.id, 1)
-	b, err := json.Marshal(h{"id": int(id), "method": method, "params": params})
+	return c.SendContext(context.Background(), method, params)
+}
+
+// SendContext is like Send, but stops waiting for the response once ctx is
+// done. In that case the pending request is forgotten and ctx.Err() is
+// returned.
+func (c *Chrome) SendContext(ctx context.Context, method string, params h) (json.RawMessage, error) {
+	raw, err := c.SendRaw(ctx, method, params)
 	if err != nil {
 		return nil, err
 	}
-	resc := make(chan result)
+	return unwrapResult(raw)
+}
+
+// SendRaw is like SendContext, but returns the response as it is, without
+// unwrapping remote objects. It is used by the typed bindings of the cdp
+// package.
+func (c *Chrome) SendRaw(ctx context.Context, method string, params h) (json.RawMessage, error) {
+	return c.send(ctx, c.session, method, params)
+}
+
+// sendBrowser sends a browser-level method, outside of the page session
+func (c *Chrome) sendBrowser(method string, params h) (json.RawMessage, error) {
+	return c.send(context.Background(), "", method, params)
+}
+
+func (c *Chrome) send(ctx context.Context, session, method string, params h) (json.RawMessage, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	id := int(atomic.AddInt32(&c.id, 1))
+	// Buffered, so that readLoop never blocks on a caller that has gone away
+	resc := make(chan result, 1)
 	c.Lock()
-	c.pending[int(id)] = resc
+	if c.reason != CloseReasonNone {
+		c.Unlock()
+		return nil, ErrBrowserClosed
+	}
+	c.pending[id] = resc
 	c.Unlock()
 
-	if err := websocket.JSON.Send(c.ws, h{
-		"id":     int(id),
-		"method": "Target.sendMessageToTarget",
-		"params": h{"message": string(b), "sessionId": c.session},
-	}); err != nil {
+	m := h{"id": id, "method": method, "params": params}
+	if session != "" {
+		m["sessionId"] = session
+	}
+	if err := c.write(m); err != nil {
+		c.forget(id)
 		return nil, err
 	}
-	res := <-resc
-	return res.Value, res.Err
+	select {
+	case res := <-resc:
+		return res.Value, res.Err
+	case <-ctx.Done():
+		c.forget(id)
+		return nil, ctx.Err()
+	}
+}
+
+// forget removes a request from the pending map, so that a late response is
+// dropped by readLoop
+func (c *Chrome) forget(id int) {
+	c.Lock()
+	delete(c.pending, id)
+	c.Unlock()
 }
 
-// Load navigates to a given URL
+// On registers a handler for the protocol event with the given method name,
+// e.g. "Page.loadEventFired" or "Network.responseReceived". Handlers are
+// called from the read loop in the order they were registered, so they must
+// not block or wait for responses from the browser - start a goroutine for
+// that. The returned function removes the handler.
+func (c *Chrome) On(method string, handler func(params json.RawMessage)) func() {
+	c.Lock()
+	defer c.Unlock()
+	c.lastID++
+	id := c.lastID
+	c.handlers[method] = append(c.handlers[method], eventHandler{id: id, f: handler})
+	return func() {
+		c.Lock()
+		defer c.Unlock()
+		handlers := c.handlers[method]
+		for i := range handlers {
+			if handlers[i].id == id {
+				c.handlers[method] = append(handlers[:i:i], handlers[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+// Notify relays protocol events with the given method names to ch. Like
+// signal.Notify it never blocks: events are dropped if ch is not ready, so ch
+// should be buffered. The returned function stops relaying.
+func (c *Chrome) Notify(ch chan<- Event, methods ...string) func() {
+	offs := []func(){}
+	for _, method := range methods {
+		method := method
+		offs = append(offs, c.On(method, func(params json.RawMessage) {
+			select {
+			case ch <- Event{Method: method, Params: params}:
+			default:
+			}
+		}))
+	}
+	return func() {
+		for _, off := range offs {
+			off()
+		}
+	}
+}
+
+func (c *Chrome) emit(method string, params json.RawMessage) {
+	c.Lock()
+	handlers := c.handlers[method]
+	c.Unlock()
+	for _, handler := range handlers {
+		handler.f(params)
+	}
+}
+
+// Load navigates to a given URL. It doesn't wait for the page to load, see
+// LoadAndWait.
 func (c *Chrome) Load(url string) error {
+	c.Lock()
+	c.url = url
+	c.Unlock()
 	_, err := c.Send("Page.navigate", h{"url": url})
 	return err
 }
 
 // Eval evaluates JavaScript expression in the browser and returns response
 func (c *Chrome) Eval(expr string) (json.RawMessage, error) {
-	return c.Send("Runtime.evaluate", h{"expression": expr, "awaitPromise": true, "returnByValue": true})
+	return c.EvalContext(context.Background(), expr)
+}
+
+// EvalContext is like Eval, but gives up waiting for the result once ctx is
+// done
+func (c *Chrome) EvalContext(ctx context.Context, expr string) (json.RawMessage, error) {
+	raw, err := c.SendRaw(ctx, "Runtime.evaluate", h{"expression": expr, "awaitPromise": true, "returnByValue": true})
+	if err != nil {
+		return nil, err
+	}
+	return evalValue(raw)
 }
 
 // AddScriptToEvaluateOnNewDocument adds JavaScript code to be evaluated
 // when new document is evaluted
 func (c *Chrome) AddScriptToEvaluateOnNewDocument(script string) error {
-	_, err := c.Send("Page.addScriptToEvaluateOnNewDocument", h{"source": script})
-	if err != nil {
+	if err := c.addScript(script); err != nil {
 		return err
 	}
-	_, err = c.Eval(script)
+	_, err := c.Eval(script)
 	return err
 }
 
-// Reload reloads current page
+// addScript registers a script to be evaluated on every new document and
+// remembers it, so that it can be registered again by Recover
+func (c *Chrome) addScript(source string) error {
+	s := &script{source: source}
+	if err := c.registerScript(s); err != nil {
+		return err
+	}
+	c.Lock()
+	c.scripts = append(c.scripts, s)
+	c.Unlock()
+	return nil
+}
+
+func (c *Chrome) registerScript(s *script) error {
+	result, err := c.Send("Page.addScriptToEvaluateOnNewDocument", h{"source": s.source})
+	if err != nil {
+		return err
+	}
+	res := struct {
+		Identifier string `json:"identifier"`
+	}{}
+	if err := json.Unmarshal(result, &res); err != nil {
+		return err
+	}
+	c.Lock()
+	s.id = res.Identifier
+	c.Unlock()
+	return nil
+}
+
+// Reload reloads current page. It doesn't wait for the page to load, see
+// ReloadAndWait.
 // https://pptr.dev/#?product=Puppeteer&show=api-pagereloadoptions
 func (c *Chrome) Reload(disableCache bool) error {
 	// TODO: should restore current cache setting
@@ -415,36 +871,51 @@ type NavigationHistoryEntry struct {
 
 // NavigationHistory represents browser navigation history
 type NavigationHistory struct {
-	CurrentIndex int `json:"currentIndex"`
-	Entries []*NavigationHistoryEntry `json:"entries"`
+	CurrentIndex int                       `json:"currentIndex"`
+	Entries      []*NavigationHistoryEntry `json:"entries"`
 }
 
 // GetNavigationHistory returns browser navigation history
 func (c *Chrome) GetNavigationHistory() (*NavigationHistory, error) {
-	result, err := c.Send("Page.getNavigationHistory", nil)
+	res, err := cdp.Page{Client: c}.GetNavigationHistory(context.Background())
 	if err != nil {
 		return nil, err
 	}
-	var h NavigationHistory
-	err = json.Unmarshal(result, &h)
-	if err != nil {
-		return nil, err
+	h := &NavigationHistory{CurrentIndex: res.CurrentIndex}
+	for _, e := range res.Entries {
+		h.Entries = append(h.Entries, &NavigationHistoryEntry{
+			ID:             int64(e.ID),
+			URL:            e.URL,
+			UserTypedURL:   e.UserTypedURL,
+			Title:          e.Title,
+			TransitionType: string(e.TransitionType),
+		})
 	}
-	return &h, nil
+	return h, nil
 }
 
-func (c *Chrome) goDelta(delta int) error {
+// historyEntry returns the ID of the navigation history entry delta steps
+// away from the current one
+func (c *Chrome) historyEntry(delta int) (int64, error) {
 	history, err := c.GetNavigationHistory()
 	if err != nil {
-		return err
+		return 0, err
 	}
 	n := history.CurrentIndex + delta
 	if n < 0 || n >= len(history.Entries) {
-		return fmt.Errorf("invalid delta %d, would navigate to %d which is outside of history length of %d", delta, n, len(history.Entries))
+		return 0, fmt.Errorf("invalid delta %d, would navigate to %d which is outside of history length of %d", delta, n, len(history.Entries))
 	}
-	e := history.Entries[n]
-	// TODO: maybe add a way to wait for navigation
-	_, err = c.Send("Page.navigateToHistoryEntry", h{"entryId": e.ID})
+	return history.Entries[n].ID, nil
+}
+
+// goDelta starts navigating through history and doesn't wait for the page to
+// load, see BackAndWait and ForwardAndWait
+func (c *Chrome) goDelta(delta int) error {
+	entry, err := c.historyEntry(delta)
+	if err != nil {
+		return err
+	}
+	_, err = c.Send("Page.navigateToHistoryEntry", h{"entryId": entry})
 	return err
 }
 
@@ -491,11 +962,10 @@ func (c *Chrome) Bind(name string, f bindingFunc) error {
 		return promise;
 	}})();
 	`, name)
-	_, err := c.Send("Page.addScriptToEvaluateOnNewDocument", h{"source": script})
-	if err != nil {
+	if err := c.addScript(script); err != nil {
 		return err
 	}
-	_, err = c.Eval(script)
+	_, err := c.Eval(script)
 	return err
 }
 
@@ -504,44 +974,59 @@ func (c *Chrome) SetBounds(b Bounds) error {
 	if b.WindowState == "" {
 		b.WindowState = WindowStateNormal
 	}
-	param := h{"windowId": c.window, "bounds": b}
-	if b.WindowState != WindowStateNormal {
-		param["bounds"] = h{"windowState": b.WindowState}
+	bounds := cdp.BrowserBounds{WindowState: cdp.BrowserWindowState(b.WindowState)}
+	// Other states can not be combined with a position or size
+	if b.WindowState == WindowStateNormal {
+		bounds.Left, bounds.Top = cdp.Int(b.Left), cdp.Int(b.Top)
+		bounds.Width, bounds.Height = cdp.Int(b.Width), cdp.Int(b.Height)
 	}
-	_, err := c.Send("Browser.setWindowBounds", param)
-	return err
+	return cdp.Browser{Client: c}.SetWindowBounds(context.Background(), &cdp.BrowserSetWindowBoundsParams{
+		WindowID: cdp.BrowserWindowID(c.window),
+		Bounds:   bounds,
+	})
 }
 
 // Bounds returns the size, position and a state of a browser window
 func (c *Chrome) Bounds() (Bounds, error) {
-	result, err := c.Send("Browser.getWindowBounds", h{"windowId": c.window})
+	res, err := cdp.Browser{Client: c}.GetWindowBounds(context.Background(), &cdp.BrowserGetWindowBoundsParams{
+		WindowID: cdp.BrowserWindowID(c.window),
+	})
 	if err != nil {
 		return Bounds{}, err
 	}
-	bounds := struct {
-		Bounds Bounds `json:"bounds"`
-	}{}
-	err = json.Unmarshal(result, &bounds)
-	return bounds.Bounds, err
+	return Bounds{
+		Left:        intValue(res.Bounds.Left),
+		Top:         intValue(res.Bounds.Top),
+		Width:       intValue(res.Bounds.Width),
+		Height:      intValue(res.Bounds.Height),
+		WindowState: WindowState(res.Bounds.WindowState),
+	}, nil
+}
+
+// intValue returns the value of an optional integer, or 0 if it is missing
+func intValue(p *int) int {
+	if p == nil {
+		return 0
+	}
+	return *p
 }
 
 // PDF generates a PDF of a given size and returns the PDF content as []byte
 func (c *Chrome) PDF(width, height int) ([]byte, error) {
-	result, err := c.Send("Page.printToPDF", h{
-		"paperWidth":  float32(width) / 96,
-		"paperHeight": float32(height) / 96,
+	res, err := cdp.Page{Client: c}.PrintToPDF(context.Background(), &cdp.PagePrintToPDFParams{
+		PaperWidth:  cdp.Float64(float64(width) / 96),
+		PaperHeight: cdp.Float64(float64(height) / 96),
 	})
 	if err != nil {
 		return nil, err
 	}
-	pdf := struct {
-		Data []byte `json:"data"`
-	}{}
-	err = json.Unmarshal(result, &pdf)
-	return pdf.Data, err
+	return base64.StdEncoding.DecodeString(res.Data)
 }
 
-// PNG generates
+// PNG captures a region of the page as a PNG image. If the region is empty
+// it is the size of the SVG document, or of an A4 page. Background is in ARGB
+// format, zero keeps the background transparent. See Screenshot for more
+// options.
 func (c *Chrome) PNG(x, y, width, height int, bg uint32, scale float32) ([]byte, error) {
 	if x == 0 && y == 0 && width == 0 && height == 0 {
 		// By default either use SVG size if it's an SVG, or use A4 page size
@@ -555,45 +1040,75 @@ func (c *Chrome) PNG(x, y, width, height int, bg uint32, scale float32) ([]byte,
 		}
 		x, y, width, height = rect[0], rect[1], rect[2], rect[3]
 	}
-
-	_, err := c.Send("Emulation.setDefaultBackgroundColorOverride", h{
-		"color": h{
-			"r": (bg >> 16) & 0xff,
-			"g": (bg >> 8) & 0xff,
-			"b": bg & 0xff,
-			"a": (bg >> 24) & 0xff,
-		},
-	})
-	if err != nil {
-		return nil, err
-	}
-	result, err := c.Send("Page.captureScreenshot", h{
-		"clip": h{
-			"x": x, "y": y, "width": width, "height": height, "scale": scale,
-		},
+	return c.Screenshot(&ScreenshotOptions{
+		Clip:           &Rect{X: float64(x), Y: float64(y), Width: float64(width), Height: float64(height)},
+		Scale:          float64(scale),
+		Background:     bg,
+		OmitBackground: bg == 0,
 	})
-	if err != nil {
-		return nil, err
-	}
-	pdf := struct {
-		Data []byte `json:"data"`
-	}{}
-	err = json.Unmarshal(result, &pdf)
-	return pdf.Data, err
 }
 
 // Kill kills the chrome process
 func (c *Chrome) Kill() error {
-	if c.ws != nil {
-		if err := c.ws.Close(); err != nil {
-			return err
-		}
+	c.shutdown(CloseReasonKilled)
+	if c.Cmd == nil || c.Cmd.Process == nil {
+		return nil
 	}
-	// TODO: cancel all pending requests
-	if state := c.Cmd.ProcessState; state == nil || !state.Exited() {
+	select {
+	case <-c.exited:
+		return nil
+	default:
 		return c.Cmd.Process.Kill()
 	}
-	return nil
+}
+
+// Reason returns why the browser session has ended, or CloseReasonNone if
+// it is still alive.
+func (c *Chrome) Reason() CloseReason {
+	c.Lock()
+	defer c.Unlock()
+	return c.reason
+}
+
+// shutdown marks the session as closed, closes the connection and fails all
+// pending requests with ErrBrowserClosed. Only the first call has an effect,
+// so the first reason wins.
+func (c *Chrome) shutdown(reason CloseReason) {
+	c.Lock()
+	if c.reason != CloseReasonNone {
+		c.Unlock()
+		return
+	}
+	c.reason = reason
+	pending := c.pending
+	c.pending = map[int]chan result{}
+	close(c.done)
+	c.Unlock()
+
+	if c.conn != nil {
+		c.conn.Close()
+	}
+	for _, resc := range pending {
+		resc <- result{Err: ErrBrowserClosed}
+	}
+	c.closeDownloads()
+}
+
+// exitReason guesses why the connection has been lost by looking at how the
+// browser process has exited.
+func (c *Chrome) exitReason() CloseReason {
+	if c.Cmd == nil {
+		return CloseReasonCrashed
+	}
+	select {
+	case <-c.exited:
+	case <-time.After(time.Second):
+		return CloseReasonCrashed
+	}
+	if c.Cmd.ProcessState.Success() {
+		return CloseReasonWindowClosed
+	}
+	return CloseReasonCrashed
 }
 
 // DisableContextMenu disables Chrome's default context menu on right mouse click
@@ -627,3 +1142,12 @@ func contains(arr []string, x string) bool {
 	}
 	return false
 }
+
+func isHeadless(args []string) bool {
+	for _, arg := range args {
+		if arg == "--headless" || strings.HasPrefix(arg, "--headless=") {
+			return true
+		}
+	}
+	return false
+}
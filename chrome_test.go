@@ -1,12 +1,26 @@
 package lorca
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"regexp"
 	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
+
+	"golang.org/x/net/websocket"
 )
 
 // TODO: made headless controllable via env "NO_HEADLESS"
@@ -33,8 +47,8 @@ func TestChromeEval(t *testing.T) {
 		{Expr: `(() => ([1,'foo',false]))()`, Result: `[1,"foo",false]`},
 		{Expr: `((a, b) => a*b)(3, 7)`, Result: `21`},
 		{Expr: `Promise.resolve(42)`, Result: `42`},
-		{Expr: `Promise.reject('foo')`, Error: `"foo"`},
-		{Expr: `throw "bar"`, Error: `"bar"`},
+		{Expr: `Promise.reject('foo')`, Error: `foo`},
+		{Expr: `throw "bar"`, Error: `bar`},
 		{Expr: `2+`, Error: `SyntaxError: Unexpected end of input`},
 	} {
 		result, err := c.Eval(test.Expr)
@@ -46,6 +60,159 @@ func TestChromeEval(t *testing.T) {
 			t.Fatal(test.Expr, string(result), test.Result)
 		}
 	}
+
+	// Send unwraps the remote object, SendRaw doesn't
+	if res, err := c.Send("Runtime.evaluate", h{"expression": `2+3`}); err != nil || string(res) != `5` {
+		t.Fatal(string(res), err)
+	}
+	if res, err := c.Send("Runtime.evaluate", h{"expression": `throw "bar"`}); err == nil || err.Error() != `bar` {
+		t.Fatal(string(res), err)
+	}
+	if res, err := c.SendRaw(context.Background(), "Runtime.evaluate", h{"expression": `throw "bar"`}); err != nil || !bytes.Contains(res, []byte(`"exceptionDetails"`)) {
+		t.Fatal(string(res), err)
+	}
+}
+
+func TestChromePipe(t *testing.T) {
+	args := []string{"--user-data-dir=/tmp", "--remote-debugging-pipe", "--headless"}
+	c, err := NewChromeWithArgs(LocateChrome(), args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Kill()
+	if res, err := c.Eval(`2+3`); err != nil || string(res) != `5` {
+		t.Fatal(string(res), err)
+	}
+}
+
+func TestChromeEvalContext(t *testing.T) {
+	args := []string{"--user-data-dir=/tmp", "--remote-debugging-port=0", "--headless"}
+	c, err := NewChromeWithArgs(LocateChrome(), args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Kill()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err := c.EvalContext(ctx, `new Promise(() => {})`); err != context.DeadlineExceeded {
+		t.Fatal(err)
+	}
+	// The connection must still be usable after a call has been abandoned
+	if res, err := c.Eval(`2+3`); err != nil || string(res) != `5` {
+		t.Fatal(string(res), err)
+	}
+}
+
+func TestChromeKillPending(t *testing.T) {
+	args := []string{"--user-data-dir=/tmp", "--remote-debugging-port=0", "--headless"}
+	c, err := NewChromeWithArgs(LocateChrome(), args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	errc := make(chan error, 1)
+	go func() {
+		_, err := c.Eval(`new Promise(() => {})`)
+		errc <- err
+	}()
+	time.Sleep(100 * time.Millisecond)
+	c.Kill()
+	if err := <-errc; err != ErrBrowserClosed {
+		t.Fatal(err)
+	}
+	if _, err := c.Eval(`2+3`); err != ErrBrowserClosed {
+		t.Fatal(err)
+	}
+	if r := c.Reason(); r != CloseReasonKilled {
+		t.Fatal(r)
+	}
+}
+
+func TestChromeConnect(t *testing.T) {
+	args := []string{"--user-data-dir=/tmp", "--remote-debugging-port=0", "--headless"}
+	c, err := NewChromeWithArgs(LocateChrome(), args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Kill()
+
+	remote, err := Connect(c.conn.(wsTransport).Config().Location.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res, err := remote.Eval(`2+3`); err != nil || string(res) != `5` {
+		t.Fatal(string(res), err)
+	}
+	// Kill only disconnects, the browser must still be alive
+	remote.Kill()
+	if res, err := c.Eval(`2+3`); err != nil || string(res) != `5` {
+		t.Fatal(string(res), err)
+	}
+}
+
+func TestChromeConnectHTTP(t *testing.T) {
+	args := []string{"--user-data-dir=/tmp", "--remote-debugging-port=0", "--headless"}
+	c, err := NewChromeWithArgs(LocateChrome(), args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Kill()
+
+	remote, err := ConnectHTTP("http://" + c.conn.(wsTransport).Config().Location.Host)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer remote.Kill()
+	if res, err := remote.Eval(`2+3`); err != nil || string(res) != `5` {
+		t.Fatal(string(res), err)
+	}
+}
+
+func TestConnectHTTPContext(t *testing.T) {
+	stuck := make(chan struct{})
+	defer close(stuck)
+	// A listener that accepts connections but never answers
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+	ws := httptest.NewServer(websocket.Handler(func(ws *websocket.Conn) { <-stuck }))
+	defer ws.Close()
+
+	for name, handler := range map[string]http.HandlerFunc{
+		"discovery": func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-stuck:
+			}
+		},
+		"handshake": func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"webSocketDebuggerUrl":"ws://` + l.Addr().String() + `/devtools/browser/1"}`))
+		},
+		"session": func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"webSocketDebuggerUrl":"ws` + strings.TrimPrefix(ws.URL, "http") + `/devtools/browser/1"}`))
+		},
+	} {
+		srv := httptest.NewServer(handler)
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		c, err := ConnectHTTPContext(ctx, srv.URL)
+		cancel()
+		srv.Close()
+		if c != nil || !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatal(name, c, err)
+		}
+	}
 }
 
 func TestChromeLoad(t *testing.T) {
@@ -57,24 +224,99 @@ func TestChromeLoad(t *testing.T) {
 		t.Fatal(err)
 	}
 	defer c.Kill()
-	if err := c.Load("data:text/html,<html><body>Hello</body></html>"); err != nil {
+	if err := c.LoadAndWait("data:text/html,<html><body>Hello</body></html>", WaitLoad, 10*time.Second); err != nil {
 		t.Fatal(err)
 	}
-	for i := 0; i < 10; i++ {
-		url, err := c.Eval(`window.location.href`)
-		if err != nil {
-			t.Fatal(err)
-		}
-		if strings.HasPrefix(string(url), `"data:text/html,`) {
-			break
-		}
+	if res, err := c.Eval(`document.body.innerText`); err != nil {
+		t.Fatal(err)
+	} else if string(res) != `"Hello"` {
+		t.Fatal(res)
+	}
+	if err := c.LoadAndWait("data:text/html,<html><body>World</body></html>", WaitDOMContentLoaded, 10*time.Second); err != nil {
+		t.Fatal(err)
 	}
-	if res, err := c.Eval(`document.body ? document.body.innerText :
-			new Promise(res => window.onload = () => res(document.body.innerText))`); err != nil {
+	if err := c.BackAndWait(WaitLoad, 10*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if res, err := c.Eval(`document.body.innerText`); err != nil {
 		t.Fatal(err)
 	} else if string(res) != `"Hello"` {
 		t.Fatal(res)
 	}
+	if err := c.LoadAndWait("http://nonexistent.invalid/", WaitLoad, 10*time.Second); err == nil {
+		t.Fatal("unresolvable host must fail")
+	}
+}
+
+func TestChromeEvents(t *testing.T) {
+	args := []string{"--user-data-dir=/tmp", "--remote-debugging-port=0", "--headless"}
+	c, err := NewChromeWithArgs(LocateChrome(), args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Kill()
+
+	loaded := make(chan struct{}, 1)
+	off := c.On("Page.loadEventFired", func(params json.RawMessage) {
+		loaded <- struct{}{}
+	})
+	defer off()
+	events := make(chan Event, 16)
+	stop := c.Notify(events, "Page.frameNavigated")
+	defer stop()
+
+	if err := c.Load("data:text/html,<html><body>Hello</body></html>"); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-loaded:
+	case <-time.After(5 * time.Second):
+		t.Fatal("no Page.loadEventFired")
+	}
+	select {
+	case e := <-events:
+		if e.Method != "Page.frameNavigated" || len(e.Params) == 0 {
+			t.Fatal(e)
+		}
+	default:
+		t.Fatal("no Page.frameNavigated")
+	}
+}
+
+func TestChromeCrash(t *testing.T) {
+	args := []string{"--user-data-dir=/tmp", "--remote-debugging-port=0", "--headless"}
+	c, err := NewChromeWithArgs(LocateChrome(), args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Kill()
+
+	if err := c.Bind("answer", func(args []json.RawMessage) (interface{}, error) {
+		return 42, nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	crashes := make(chan CrashKind, 1)
+	c.OnCrash(func(kind CrashKind) { crashes <- kind })
+	c.SetAutoRecover(true)
+
+	go c.Send("Page.crash", nil)
+	select {
+	case kind := <-crashes:
+		if kind != CrashKindCrashed {
+			t.Fatal(kind)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("crash not detected")
+	}
+	// The page is reloaded and the binding is installed again
+	for i := 0; i < 50; i++ {
+		if res, err := c.Eval(`window.answer()`); err == nil && string(res) == `42` {
+			return
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	t.Fatal("page not recovered")
 }
 
 func TestChromeBind(t *testing.T) {
@@ -154,3 +396,464 @@ func TestChromeAsync(t *testing.T) {
 		t.Fatal()
 	}
 }
+
+func TestChromeWait(t *testing.T) {
+	args := []string{"--user-data-dir=/tmp", "--remote-debugging-port=0", "--headless"}
+	c, err := NewChromeWithArgs(LocateChrome(), args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Kill()
+
+	if err := c.LoadAndWait(`data:text/html,<html><body><div id="a" style="display:none"></div></body></html>`,
+		WaitLoad, 10*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Eval(`setTimeout(() => window.answer = 42, 100)`); err != nil {
+		t.Fatal(err)
+	}
+	if res, err := c.WaitForFunction(`window.answer`, &WaitOptions{Timeout: 5 * time.Second}); err != nil {
+		t.Fatal(err)
+	} else if string(res) != `42` {
+		t.Fatal(string(res))
+	}
+	if _, err := c.WaitForFunction(`window.never`, &WaitOptions{Timeout: 100 * time.Millisecond}); err != context.DeadlineExceeded {
+		t.Fatal(err)
+	}
+	if _, err := c.WaitForFunction(`undefinedFunction()`, nil); err == nil {
+		t.Fatal("exception must fail")
+	}
+
+	if err := c.WaitForSelector(`#a`, &WaitOptions{Polling: PollingMutation, Timeout: 5 * time.Second}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.WaitForSelector(`#a`, &WaitOptions{Visible: true, Timeout: 100 * time.Millisecond}); err != context.DeadlineExceeded {
+		t.Fatal(err)
+	}
+	if _, err := c.Eval(`setTimeout(() => document.getElementById('a').innerText = document.getElementById('a').style.display = 'block', 100)`); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.WaitForSelector(`#a`, &WaitOptions{Visible: true, Polling: PollingMutation, Timeout: 5 * time.Second}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.WaitForSelector(`#b`, &WaitOptions{Hidden: true, Timeout: 5 * time.Second}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.WaitForSelector(`#a`, &WaitOptions{Polling: "sometimes"}); err == nil {
+		t.Fatal("unknown polling mode must fail")
+	}
+}
+
+func TestChromeElement(t *testing.T) {
+	args := []string{"--user-data-dir=/tmp", "--remote-debugging-port=0", "--headless"}
+	c, err := NewChromeWithArgs(LocateChrome(), args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Kill()
+
+	if err := c.LoadAndWait(`data:text/html,<html><body>
+		<button id="b" title="Press" onclick="this.innerText = 'Pressed'">Press me</button>
+		<input id="i" onfocus="window.focused = true">
+		<input id="f" type="file">
+		<p>one</p><p>two</p>
+	</body></html>`, WaitLoad, 10*time.Second); err != nil {
+		t.Fatal(err)
+	}
+
+	if e, err := c.Query(`#missing`); err != nil || e != nil {
+		t.Fatal(e, err)
+	}
+	b, err := c.Query(`#b`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer b.Release()
+	if s, err := b.Text(); err != nil || s != "Press me" {
+		t.Fatal(s, err)
+	}
+	if s, err := b.Attr("title"); err != nil || s != "Press" {
+		t.Fatal(s, err)
+	}
+	if s, err := b.HTML(); err != nil || s != `<button id="b" title="Press" onclick="this.innerText = 'Pressed'">Press me</button>` {
+		t.Fatal(s, err)
+	}
+	if r, err := b.BoundingBox(); err != nil || r.Width == 0 || r.Height == 0 {
+		t.Fatal(r, err)
+	}
+	if img, err := b.Screenshot(); err != nil || len(img) == 0 {
+		t.Fatal(err)
+	}
+	if err := b.Click(); err != nil {
+		t.Fatal(err)
+	}
+	if s, err := b.Text(); err != nil || s != "Pressed" {
+		t.Fatal(s, err)
+	}
+
+	i, err := c.Query(`#i`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer i.Release()
+	if err := i.Type("Hello"); err != nil {
+		t.Fatal(err)
+	}
+	if res, err := c.Call(`(i) => window.focused && i.value`, i); err != nil || string(res) != `"Hello"` {
+		t.Fatal(string(res), err)
+	}
+
+	f, err := c.Query(`#f`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Release()
+	if err := f.SetFiles("chrome_test.go"); err != nil {
+		t.Fatal(err)
+	}
+	if res, err := c.Call(`(f) => f.files[0].name`, f); err != nil || string(res) != `"chrome_test.go"` {
+		t.Fatal(string(res), err)
+	}
+
+	ps, err := c.QueryAll(`p`)
+	if err != nil || len(ps) != 2 {
+		t.Fatal(ps, err)
+	}
+	for n, p := range ps {
+		if s, err := p.Text(); err != nil || s != []string{"one", "two"}[n] {
+			t.Fatal(s, err)
+		}
+		p.Release()
+	}
+}
+
+func TestChromeInput(t *testing.T) {
+	args := []string{"--user-data-dir=/tmp", "--remote-debugging-port=0", "--headless"}
+	c, err := NewChromeWithArgs(LocateChrome(), args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Kill()
+
+	if err := c.DisableDefaultShortcuts(); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.LoadAndWait(`data:text/html,<html><body style="height:5000px">
+		<input id="i" style="position:fixed;left:0;top:0;width:200px;height:20px">
+		<script>
+			window.keys = [];
+			window.typed = [];
+			window.clicks = [];
+			const modifiers = ['Control', 'Shift', 'Alt', 'Meta'];
+			document.addEventListener('keydown', e => modifiers.includes(e.key) || setTimeout(() => keys.push(e.code + (e.defaultPrevented ? ' prevented' : ''))));
+			document.getElementById('i').addEventListener('keydown', e => typed.push(e.key + (e.shiftKey ? ' shift' : '')));
+			document.addEventListener('click', e => clicks.push(e.clientX + ',' + e.clientY + (e.shiftKey ? ' shift' : '')));
+			document.addEventListener('touchstart', e => window.touched = true);
+		</script>
+	</body></html>`, WaitLoad, 10*time.Second); err != nil {
+		t.Fatal(err)
+	}
+
+	kb := c.Keyboard()
+	for _, keys := range []string{"KeyA", "Ctrl+KeyN", "Ctrl+Shift+KeyQ", "Ctrl+KeyQ", "F4", "Alt+ArrowLeft"} {
+		if err := kb.Press(keys); err != nil {
+			t.Fatal(keys, err)
+		}
+	}
+	if res, err := c.WaitForFunction(`keys.length === 6 && keys`, &WaitOptions{Timeout: 5 * time.Second}); err != nil {
+		t.Fatal(err)
+	} else if string(res) != `["KeyA","KeyN prevented","KeyQ prevented","KeyQ prevented","F4 prevented","ArrowLeft prevented"]` {
+		t.Fatal(string(res))
+	}
+
+	if err := c.Mouse().Click(100, 10, MouseLeft); err != nil {
+		t.Fatal(err)
+	}
+	if err := kb.Type("Hi!é"); err != nil {
+		t.Fatal(err)
+	}
+	var value string
+	if res, err := c.Eval(`document.getElementById('i').value`); err != nil || json.Unmarshal(res, &value) != nil || value != "Hi!é" {
+		t.Fatal(string(res), err)
+	}
+	if res, err := c.Eval(`typed`); err != nil || string(res) != `["H shift","i","! shift"]` {
+		t.Fatal(string(res), err)
+	}
+
+	if err := kb.Down("Shift"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Mouse().Click(50, 300, MouseLeft); err != nil {
+		t.Fatal(err)
+	}
+	if err := kb.Up("Shift"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Mouse().Wheel(0, 100); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Touch().Tap(50, 300); err != nil {
+		t.Fatal(err)
+	}
+	if res, err := c.WaitForFunction(`window.touched && window.scrollY > 0 && clicks.slice(0, 2)`, &WaitOptions{Timeout: 5 * time.Second}); err != nil {
+		t.Fatal(err)
+	} else if string(res) != `["100,10","50,300 shift"]` {
+		t.Fatal(string(res))
+	}
+}
+
+func TestChromeMock(t *testing.T) {
+	args := []string{"--user-data-dir=/tmp", "--remote-debugging-port=0", "--headless"}
+	c, err := NewChromeWithArgs(LocateChrome(), args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Kill()
+
+	if _, err := c.ServeHandler("https://app.test", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<html><body>` + r.URL.Path + `</body></html>`))
+	})); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Mock("https://app.test/api/*", MockBody(200, "application/json", []byte(`{"users":2}`))); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.MockRegexp(regexp.MustCompile(`/api/down$`), MockFail("ConnectionRefused"), "https://app.test/api/*"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.LoadAndWait("https://app.test/index.html", WaitLoad, 10*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if res, err := c.Eval(`document.body.innerText`); err != nil || string(res) != `"/index.html"` {
+		t.Fatal(string(res), err)
+	}
+	if res, err := c.Eval(`fetch('/api/users').then(r => r.json())`); err != nil || string(res) != `{"users":2}` {
+		t.Fatal(string(res), err)
+	}
+	if _, err := c.Eval(`fetch('/api/down')`); err == nil {
+		t.Fatal("mocked failure must fail")
+	}
+}
+
+func TestChromeStorage(t *testing.T) {
+	args := []string{"--user-data-dir=/tmp", "--remote-debugging-port=0", "--headless"}
+	c, err := NewChromeWithArgs(LocateChrome(), args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Kill()
+
+	if _, err := c.ServeHandler("https://app.test", http.NotFoundHandler()); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.LoadAndWait("https://app.test/", WaitLoad, 10*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SetCookies(Cookie{Name: "sid", Value: "abc", URL: "https://app.test/", Secure: true}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SetStorage("https://app.test", LocalStorage, map[string]string{"token": "t1"}); err != nil {
+		t.Fatal(err)
+	}
+	if res, err := c.Eval(`document.cookie + ' ' + localStorage.getItem('token')`); err != nil || string(res) != `"sid=abc t1"` {
+		t.Fatal(string(res), err)
+	}
+	if err := c.ClearSiteData("https://app.test"); err != nil {
+		t.Fatal(err)
+	}
+	if cookies, err := c.Cookies("https://app.test/"); err != nil || len(cookies) != 0 {
+		t.Fatal(cookies, err)
+	}
+	if items, err := c.Storage("https://app.test", LocalStorage); err != nil || len(items) != 0 {
+		t.Fatal(items, err)
+	}
+}
+
+func TestChromeDownload(t *testing.T) {
+	args := []string{"--user-data-dir=/tmp", "--remote-debugging-port=0", "--headless"}
+	c, err := NewChromeWithArgs(LocateChrome(), args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Kill()
+
+	if _, err := c.ServeHandler("https://app.test", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/report.csv" {
+			w.Header().Set("Content-Disposition", `attachment; filename="report.csv"`)
+			w.Write([]byte("a,b\n1,2\n"))
+			return
+		}
+		w.Write([]byte(`<a href="/report.csv">report</a>`))
+	})); err != nil {
+		t.Fatal(err)
+	}
+	target := filepath.Join(t.TempDir(), "saved.csv")
+	downloads := make(chan *Download, 1)
+	off, err := c.OnDownload(func(d *Download) {
+		d.SaveAs(target)
+		downloads <- d
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer off()
+	if err := c.LoadAndWait("https://app.test/", WaitLoad, 10*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Eval(`document.querySelector('a').click()`); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case d := <-downloads:
+		if path, err := d.Wait(); err != nil || path != target || d.SuggestedFilename != "report.csv" {
+			t.Fatal(path, err, d.SuggestedFilename)
+		}
+		if b, err := ioutil.ReadFile(target); err != nil || string(b) != "a,b\n1,2\n" {
+			t.Fatal(string(b), err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("download has not started")
+	}
+}
+
+func TestChromeFileChooser(t *testing.T) {
+	args := []string{"--user-data-dir=/tmp", "--remote-debugging-port=0", "--headless"}
+	c, err := NewChromeWithArgs(LocateChrome(), args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Kill()
+
+	if _, err := c.ServeHandler("https://app.test", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<input type="file" accept=".txt" multiple>`))
+	})); err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(t.TempDir(), "notes.txt")
+	if err := ioutil.WriteFile(file, []byte("hello"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	off, err := c.OnFileChooser(func(mode FileChooserMode, accept string) ([]string, error) {
+		if mode != FileChooserMultiple || accept != ".txt" {
+			t.Error(mode, accept)
+		}
+		return []string{file}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer off()
+	if err := c.LoadAndWait("https://app.test/", WaitLoad, 10*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	input, err := c.Query("input")
+	if err != nil || input == nil {
+		t.Fatal(input, err)
+	}
+	if err := input.Click(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.WaitForFunction(`document.querySelector('input').files.length == 1`, nil); err != nil {
+		t.Fatal(err)
+	}
+	if res, err := c.Eval(`document.querySelector('input').files[0].name`); err != nil || string(res) != `"notes.txt"` {
+		t.Fatal(string(res), err)
+	}
+}
+
+func TestChromeDialog(t *testing.T) {
+	args := []string{"--user-data-dir=/tmp", "--remote-debugging-port=0", "--headless"}
+	c, err := NewChromeWithArgs(LocateChrome(), args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Kill()
+
+	// Headless browsers answer dialogs by default instead of blocking
+	if res, err := c.Eval(`alert('hi'), confirm('sure?')`); err != nil || string(res) != `false` {
+		t.Fatal(string(res), err)
+	}
+	off := c.OnDialog(func(d Dialog) (bool, string) {
+		return true, d.DefaultPrompt + "!"
+	})
+	defer off()
+	if res, err := c.Eval(`prompt('name?', 'lorca')`); err != nil || string(res) != `"lorca!"` {
+		t.Fatal(string(res), err)
+	}
+}
+
+func TestChromeScreenshot(t *testing.T) {
+	args := []string{"--user-data-dir=/tmp", "--remote-debugging-port=0", "--headless"}
+	c, err := NewChromeWithArgs(LocateChrome(), args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Kill()
+
+	if _, err := c.ServeHandler("https://app.test", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<body style="margin:0"><div id="box" style="width:50px;height:30px;margin-top:3000px;background:red"></div></body>`))
+	})); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.LoadAndWait("https://app.test/", WaitLoad, 10*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		Opts          ScreenshotOptions
+		Width, Height int
+		Magic         string
+	}{
+		{Opts: ScreenshotOptions{Selector: "#box"}, Width: 50, Height: 30, Magic: "\x89PNG"},
+		{Opts: ScreenshotOptions{Selector: "#box", Scale: 2, Format: "jpeg", Quality: 50}, Width: 100, Height: 60, Magic: "\xff\xd8\xff"},
+		{Opts: ScreenshotOptions{Clip: &Rect{Width: 20, Height: 10}, Format: "webp"}, Width: 20, Height: 10, Magic: "RIFF"},
+	} {
+		img, err := c.Screenshot(&test.Opts)
+		if err != nil || !bytes.HasPrefix(img, []byte(test.Magic)) {
+			t.Fatal(test.Opts, err)
+		}
+		if test.Opts.Format == "webp" {
+			continue
+		}
+		cfg, _, err := image.DecodeConfig(bytes.NewReader(img))
+		if err != nil || cfg.Width != test.Width || cfg.Height != test.Height {
+			t.Fatal(test.Opts, cfg, err)
+		}
+	}
+	img, err := c.Screenshot(&ScreenshotOptions{FullPage: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg, _, err := image.DecodeConfig(bytes.NewReader(img)); err != nil || cfg.Height != 3030 {
+		t.Fatal(cfg, err)
+	}
+	if _, err := c.Screenshot(&ScreenshotOptions{Selector: "#missing"}); err == nil {
+		t.Fatal("missing element must fail")
+	}
+}
+
+func TestChromeRecord(t *testing.T) {
+	buf := &bytes.Buffer{}
+	args := []string{"--user-data-dir=/tmp", "--remote-debugging-port=0", "--headless"}
+	c, err := RecordChromeWithArgs(buf, LocateChrome(), args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res, err := c.Eval(`2+3`); err != nil || string(res) != `5` {
+		t.Fatal(string(res), err)
+	}
+	c.Kill()
+
+	r, err := NewReplayer(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err = NewChromeWithTransport(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Kill()
+	if res, err := c.Eval(`2+3`); err != nil || string(res) != `5` {
+		t.Fatal(string(res), err)
+	}
+}
@@ -0,0 +1,391 @@
+package lorca
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// ConsoleLevel is a severity of a console message
+type ConsoleLevel int
+
+const (
+	// ConsoleLevelDebug is used for console.debug and verbose browser messages
+	ConsoleLevelDebug ConsoleLevel = iota
+	// ConsoleLevelInfo is used for console.log, console.info and the like
+	ConsoleLevelInfo
+	// ConsoleLevelWarning is used for console.warn and browser warnings
+	ConsoleLevelWarning
+	// ConsoleLevelError is used for console.error, failed assertions and
+	// uncaught exceptions
+	ConsoleLevelError
+)
+
+func (l ConsoleLevel) String() string {
+	switch l {
+	case ConsoleLevelDebug:
+		return "debug"
+	case ConsoleLevelInfo:
+		return "info"
+	case ConsoleLevelWarning:
+		return "warning"
+	case ConsoleLevelError:
+		return "error"
+	}
+	return fmt.Sprintf("ConsoleLevel(%d)", int(l))
+}
+
+// StackFrame is a single JavaScript call frame. Line and column numbers are
+// 1-based.
+type StackFrame struct {
+	Function string
+	URL      string
+	Line     int
+	Column   int
+}
+
+func (f StackFrame) String() string {
+	function := f.Function
+	if function == "" {
+		function = "<anonymous>"
+	}
+	return fmt.Sprintf("%s (%s:%d:%d)", function, f.URL, f.Line, f.Column)
+}
+
+// ConsoleMessage is a message logged by the page with the console API, an
+// uncaught exception, or a message logged by the browser itself.
+type ConsoleMessage struct {
+	Level ConsoleLevel
+	// Source is "console-api", "exception", a browser log source like
+	// "network" or "security", or "lorca" for errors in background work of
+	// lorca itself
+	Source string
+	// Type is the console API method, e.g. "log" or "table"
+	Type string
+	// Text is the formatted message, the formatted arguments joined with
+	// spaces for the console API
+	Text string
+	// Args are the formatted arguments of a console API call
+	Args []string
+	// URL, Line and Column tell where the message comes from, if known
+	URL       string
+	Line      int
+	Column    int
+	Stack     []StackFrame
+	Timestamp time.Time
+}
+
+func (m ConsoleMessage) String() string {
+	s := fmt.Sprintf("[%s] %s", m.Level, m.Text)
+	if m.URL != "" {
+		s = s + fmt.Sprintf(" (%s:%d:%d)", m.URL, m.Line, m.Column)
+	}
+	return s
+}
+
+// ConsoleHandler receives console messages, similar to slog.Handler. Handle
+// is called from the read loop and must not block.
+type ConsoleHandler interface {
+	Enabled(level ConsoleLevel) bool
+	Handle(m ConsoleMessage)
+}
+
+type logConsole struct {
+	l   *log.Logger
+	min ConsoleLevel
+	// quiet drops verbose browser log entries, which are mostly noise
+	quiet bool
+}
+
+// LogConsole returns a console handler that prints messages of at least the
+// given level to l, or to the standard logger if l is nil.
+func LogConsole(l *log.Logger, min ConsoleLevel) ConsoleHandler {
+	return logConsole{l: l, min: min}
+}
+
+// defaultConsole prints all console API messages and exceptions, but only
+// the browser log entries above the verbose level
+func defaultConsole() ConsoleHandler {
+	return logConsole{min: ConsoleLevelDebug, quiet: true}
+}
+
+func (h logConsole) Enabled(level ConsoleLevel) bool { return level >= h.min }
+func (h logConsole) Handle(m ConsoleMessage) {
+	if h.quiet && m.Level == ConsoleLevelDebug && m.Source != "console-api" {
+		return
+	}
+	s := m.String()
+	for _, f := range m.Stack {
+		s = s + "\n    at " + f.String()
+	}
+	if h.l == nil {
+		log.Println(s)
+	} else {
+		h.l.Println(s)
+	}
+}
+
+type chanConsole chan<- ConsoleMessage
+
+// ChanConsole returns a console handler that sends messages to ch. Like
+// signal.Notify it never blocks: messages are dropped if ch is not ready.
+func ChanConsole(ch chan<- ConsoleMessage) ConsoleHandler { return chanConsole(ch) }
+
+func (h chanConsole) Enabled(level ConsoleLevel) bool { return true }
+func (h chanConsole) Handle(m ConsoleMessage) {
+	select {
+	case h <- m:
+	default:
+	}
+}
+
+type discardConsole struct{}
+
+func (discardConsole) Enabled(level ConsoleLevel) bool { return false }
+func (discardConsole) Handle(m ConsoleMessage)         {}
+
+// DiscardConsole is a console handler that drops all messages
+var DiscardConsole ConsoleHandler = discardConsole{}
+
+// SetConsoleHandler sets where console messages and browser log entries go.
+// By default they are printed to the standard logger, except for verbose
+// browser log entries.
+func (c *Chrome) SetConsoleHandler(handler ConsoleHandler) {
+	c.Lock()
+	c.console = handler
+	c.Unlock()
+}
+
+// reportError passes an error of lorca itself, which has no caller to return
+// it to, to the console handler
+func (c *Chrome) reportError(text string) {
+	c.Lock()
+	handler := c.console
+	c.Unlock()
+	m := ConsoleMessage{Level: ConsoleLevelError, Source: "lorca", Text: text, Timestamp: time.Now()}
+	if handler.Enabled(m.Level) {
+		handler.Handle(m)
+	}
+}
+
+// watchConsole subscribes to the console, exception and log events. It must
+// be called before the read loop is started.
+func (c *Chrome) watchConsole() {
+	for method, parse := range map[string]func(json.RawMessage) (ConsoleMessage, error){
+		"Runtime.consoleAPICalled": parseConsoleAPICalled,
+		"Runtime.exceptionThrown":  parseExceptionThrown,
+		"Log.entryAdded":           parseLogEntry,
+	} {
+		parse := parse
+		c.On(method, func(params json.RawMessage) {
+			c.Lock()
+			handler := c.console
+			c.Unlock()
+			if m, err := parse(params); err == nil && handler.Enabled(m.Level) {
+				handler.Handle(m)
+			}
+		})
+	}
+}
+
+// remoteObject is a mirror of a JavaScript value, Runtime.RemoteObject
+type remoteObject struct {
+	Type                string          `json:"type"`
+	Subtype             string          `json:"subtype"`
+	ClassName           string          `json:"className"`
+	Value               json.RawMessage `json:"value"`
+	UnserializableValue string          `json:"unserializableValue"`
+	Description         string          `json:"description"`
+	ObjectID            string          `json:"objectId"`
+	Preview             *objectPreview  `json:"preview"`
+}
+
+type objectPreview struct {
+	Subtype    string `json:"subtype"`
+	Overflow   bool   `json:"overflow"`
+	Properties []struct {
+		Name    string `json:"name"`
+		Type    string `json:"type"`
+		Subtype string `json:"subtype"`
+		Value   string `json:"value"`
+	} `json:"properties"`
+}
+
+// format renders a value roughly the way DevTools console shows it
+func (o remoteObject) format() string {
+	switch {
+	case o.Type == "string":
+		s := ""
+		json.Unmarshal(o.Value, &s)
+		return s
+	case o.UnserializableValue != "":
+		return o.UnserializableValue
+	case o.Type == "undefined":
+		return "undefined"
+	case o.Value != nil && o.Type != "object":
+		return string(o.Value)
+	case o.Subtype == "null":
+		return "null"
+	case o.Preview != nil && (o.Preview.Subtype == "array" || o.Preview.Subtype == ""):
+		items := []string{}
+		for _, p := range o.Preview.Properties {
+			v := p.Value
+			if p.Type == "string" {
+				v = fmt.Sprintf("%q", v)
+			} else if p.Type == "object" && p.Subtype != "null" {
+				v = "{…}"
+			}
+			if o.Preview.Subtype == "array" {
+				items = append(items, v)
+			} else {
+				items = append(items, p.Name+": "+v)
+			}
+		}
+		if o.Preview.Overflow {
+			items = append(items, "…")
+		}
+		if o.Preview.Subtype == "array" {
+			return "[" + strings.Join(items, ", ") + "]"
+		}
+		return "{" + strings.Join(items, ", ") + "}"
+	}
+	return o.Description
+}
+
+type stackTrace struct {
+	CallFrames []struct {
+		FunctionName string `json:"functionName"`
+		URL          string `json:"url"`
+		LineNumber   int    `json:"lineNumber"`
+		ColumnNumber int    `json:"columnNumber"`
+	} `json:"callFrames"`
+}
+
+func (s *stackTrace) frames() []StackFrame {
+	if s == nil {
+		return nil
+	}
+	frames := []StackFrame{}
+	for _, f := range s.CallFrames {
+		frames = append(frames, StackFrame{
+			Function: f.FunctionName,
+			URL:      f.URL,
+			Line:     f.LineNumber + 1,
+			Column:   f.ColumnNumber + 1,
+		})
+	}
+	return frames
+}
+
+// timestamp converts protocol milliseconds since epoch to time
+func timestamp(ms float64) time.Time {
+	return time.Unix(0, int64(ms*float64(time.Millisecond)))
+}
+
+func parseConsoleAPICalled(params json.RawMessage) (ConsoleMessage, error) {
+	e := struct {
+		Type       string         `json:"type"`
+		Args       []remoteObject `json:"args"`
+		Timestamp  float64        `json:"timestamp"`
+		StackTrace *stackTrace    `json:"stackTrace"`
+	}{}
+	if err := json.Unmarshal(params, &e); err != nil {
+		return ConsoleMessage{}, err
+	}
+	m := ConsoleMessage{
+		Level:     ConsoleLevelInfo,
+		Source:    "console-api",
+		Type:      e.Type,
+		Stack:     e.StackTrace.frames(),
+		Timestamp: timestamp(e.Timestamp),
+	}
+	switch e.Type {
+	case "debug":
+		m.Level = ConsoleLevelDebug
+	case "warning":
+		m.Level = ConsoleLevelWarning
+	case "error", "assert":
+		m.Level = ConsoleLevelError
+	}
+	for _, arg := range e.Args {
+		m.Args = append(m.Args, arg.format())
+	}
+	m.Text = strings.Join(m.Args, " ")
+	if len(m.Stack) > 0 {
+		m.URL, m.Line, m.Column = m.Stack[0].URL, m.Stack[0].Line, m.Stack[0].Column
+	}
+	return m, nil
+}
+
+func parseExceptionThrown(params json.RawMessage) (ConsoleMessage, error) {
+	e := struct {
+		Timestamp float64          `json:"timestamp"`
+		Details   exceptionDetails `json:"exceptionDetails"`
+	}{}
+	if err := json.Unmarshal(params, &e); err != nil {
+		return ConsoleMessage{}, err
+	}
+	m := ConsoleMessage{
+		Level:     ConsoleLevelError,
+		Source:    "exception",
+		Text:      e.Details.Text,
+		URL:       e.Details.URL,
+		Line:      e.Details.LineNumber + 1,
+		Column:    e.Details.ColumnNumber + 1,
+		Stack:     e.Details.StackTrace.frames(),
+		Timestamp: timestamp(e.Timestamp),
+	}
+	if ex := e.Details.Exception; ex != nil {
+		m.Text = m.Text + " " + strings.SplitN(ex.format(), "\n", 2)[0]
+	}
+	return m, nil
+}
+
+func parseLogEntry(params json.RawMessage) (ConsoleMessage, error) {
+	e := struct {
+		Entry struct {
+			Source     string      `json:"source"`
+			Level      string      `json:"level"`
+			Text       string      `json:"text"`
+			Timestamp  float64     `json:"timestamp"`
+			URL        string      `json:"url"`
+			LineNumber int         `json:"lineNumber"`
+			StackTrace *stackTrace `json:"stackTrace"`
+		} `json:"entry"`
+	}{}
+	if err := json.Unmarshal(params, &e); err != nil {
+		return ConsoleMessage{}, err
+	}
+	m := ConsoleMessage{
+		Level:     ConsoleLevelInfo,
+		Source:    e.Entry.Source,
+		Text:      e.Entry.Text,
+		URL:       e.Entry.URL,
+		Stack:     e.Entry.StackTrace.frames(),
+		Timestamp: timestamp(e.Entry.Timestamp),
+	}
+	if m.URL != "" {
+		m.Line = e.Entry.LineNumber + 1
+	}
+	switch e.Entry.Level {
+	case "verbose":
+		m.Level = ConsoleLevelDebug
+	case "warning":
+		m.Level = ConsoleLevelWarning
+	case "error":
+		m.Level = ConsoleLevelError
+	}
+	return m, nil
+}
+
+// exceptionDetails describes an exception, Runtime.ExceptionDetails
+type exceptionDetails struct {
+	Text         string        `json:"text"`
+	LineNumber   int           `json:"lineNumber"`
+	ColumnNumber int           `json:"columnNumber"`
+	URL          string        `json:"url"`
+	StackTrace   *stackTrace   `json:"stackTrace"`
+	Exception    *remoteObject `json:"exception"`
+}
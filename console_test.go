@@ -0,0 +1,101 @@
+package lorca
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"os"
+	"testing"
+)
+
+func TestConsoleAPICalled(t *testing.T) {
+	m, err := parseConsoleAPICalled(json.RawMessage(`{
+		"type": "warning",
+		"timestamp": 1500000000000.5,
+		"args": [
+			{"type": "string", "value": "Multiple values:"},
+			{"type": "object", "subtype": "array", "description": "Array(3)", "preview": {
+				"subtype": "array", "overflow": false, "properties": [
+					{"name": "0", "type": "number", "value": "1"},
+					{"name": "1", "type": "boolean", "value": "false"},
+					{"name": "2", "type": "object", "value": "Object"}
+				]}},
+			{"type": "number", "value": 42, "description": "42"},
+			{"type": "number", "unserializableValue": "NaN", "description": "NaN"},
+			{"type": "undefined"}
+		],
+		"stackTrace": {"callFrames": [
+			{"functionName": "f", "url": "http://app/main.js", "lineNumber": 9, "columnNumber": 4}
+		]}
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Level != ConsoleLevelWarning || m.Source != "console-api" || m.Type != "warning" {
+		t.Fatal(m)
+	}
+	if m.Text != `Multiple values: [1, false, {…}] 42 NaN undefined` {
+		t.Fatal(m.Text)
+	}
+	if m.URL != "http://app/main.js" || m.Line != 10 || m.Column != 5 || len(m.Stack) != 1 {
+		t.Fatal(m)
+	}
+	if m.Timestamp.Unix() != 1500000000 {
+		t.Fatal(m.Timestamp)
+	}
+}
+
+func TestConsoleException(t *testing.T) {
+	m, err := parseExceptionThrown(json.RawMessage(`{
+		"timestamp": 1500000000000,
+		"exceptionDetails": {
+			"text": "Uncaught", "lineNumber": 0, "columnNumber": 6, "url": "http://app/main.js",
+			"exception": {"type": "object", "subtype": "error", "className": "Error",
+				"description": "Error: boom\n    at http://app/main.js:1:7"}
+		}
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Level != ConsoleLevelError || m.Text != "Uncaught Error: boom" || m.Line != 1 || m.Column != 7 {
+		t.Fatal(m)
+	}
+}
+
+func TestConsoleHandlers(t *testing.T) {
+	ch := make(chan ConsoleMessage, 1)
+	h := ChanConsole(ch)
+	h.Handle(ConsoleMessage{Text: "a"})
+	h.Handle(ConsoleMessage{Text: "b"}) // dropped, the channel is full
+	if m := <-ch; m.Text != "a" {
+		t.Fatal(m)
+	}
+	if DiscardConsole.Enabled(ConsoleLevelError) {
+		t.Fatal()
+	}
+	if h := LogConsole(nil, ConsoleLevelWarning); h.Enabled(ConsoleLevelInfo) || !h.Enabled(ConsoleLevelError) {
+		t.Fatal()
+	}
+}
+
+func TestDefaultConsole(t *testing.T) {
+	buf := &bytes.Buffer{}
+	log.SetOutput(buf)
+	defer log.SetOutput(os.Stderr)
+	log.SetFlags(0)
+	defer log.SetFlags(log.LstdFlags)
+
+	h := defaultConsole()
+	for _, m := range []ConsoleMessage{
+		{Level: ConsoleLevelDebug, Source: "console-api", Text: "debug"},
+		{Level: ConsoleLevelDebug, Source: "violation", Text: "verbose"},
+		{Level: ConsoleLevelWarning, Source: "network", Text: "warning"},
+	} {
+		if h.Enabled(m.Level) {
+			h.Handle(m)
+		}
+	}
+	if buf.String() != "[debug] debug\n[warning] warning\n" {
+		t.Fatal(buf.String())
+	}
+}
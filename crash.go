@@ -0,0 +1,227 @@
+package lorca
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+)
+
+// CrashKind tells how the page has failed
+type CrashKind int
+
+const (
+	// CrashKindCrashed means that the renderer process of the page has died
+	CrashKindCrashed CrashKind = iota + 1
+	// CrashKindUnresponsive means that the page hasn't answered a watchdog
+	// ping in time
+	CrashKindUnresponsive
+)
+
+func (k CrashKind) String() string {
+	switch k {
+	case CrashKindCrashed:
+		return "crashed"
+	case CrashKindUnresponsive:
+		return "unresponsive"
+	}
+	return "unknown"
+}
+
+// script is a script registered with Page.addScriptToEvaluateOnNewDocument
+type script struct {
+	source string
+	id     string
+}
+
+type crashHandler struct {
+	id int
+	f  func(CrashKind)
+}
+
+type crashState struct {
+	handlers    []crashHandler
+	autoRecover bool
+	// dialog is set while a JavaScript dialog blocks the page, which then
+	// can't answer watchdog pings
+	dialog bool
+}
+
+// watchCrashes keeps track of the current URL and listens for renderer
+// crashes. It must be called before the read loop is started.
+func (c *Chrome) watchCrashes() {
+	c.On("Page.frameNavigated", func(params json.RawMessage) {
+		res := struct {
+			Frame struct {
+				ParentID    string `json:"parentId"`
+				URL         string `json:"url"`
+				URLFragment string `json:"urlFragment"`
+			} `json:"frame"`
+		}{}
+		if json.Unmarshal(params, &res) == nil && res.Frame.ParentID == "" {
+			c.Lock()
+			c.url = res.Frame.URL + res.Frame.URLFragment
+			c.crash.dialog = false
+			c.Unlock()
+		}
+	})
+	c.On("Inspector.targetCrashed", func(params json.RawMessage) {
+		c.setDialogOpen(false)
+		go c.crashed(CrashKindCrashed)
+	})
+	c.On("Page.javascriptDialogOpening", func(params json.RawMessage) {
+		c.setDialogOpen(true)
+	})
+	c.On("Page.javascriptDialogClosed", func(params json.RawMessage) {
+		c.setDialogOpen(false)
+	})
+}
+
+func (c *Chrome) setDialogOpen(open bool) {
+	c.Lock()
+	c.crash.dialog = open
+	c.Unlock()
+}
+
+func (c *Chrome) dialogOpen() bool {
+	c.Lock()
+	defer c.Unlock()
+	return c.crash.dialog
+}
+
+// OnCrash registers a function to be called when the page crashes or becomes
+// unresponsive. Unresponsive pages are only detected while a Watchdog is
+// running. The returned function removes the handler.
+func (c *Chrome) OnCrash(f func(kind CrashKind)) func() {
+	c.Lock()
+	defer c.Unlock()
+	c.lastID++
+	id := c.lastID
+	c.crash.handlers = append(c.crash.handlers, crashHandler{id: id, f: f})
+	return func() {
+		c.Lock()
+		defer c.Unlock()
+		handlers := c.crash.handlers
+		for i := range handlers {
+			if handlers[i].id == id {
+				c.crash.handlers = append(handlers[:i:i], handlers[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+// SetAutoRecover enables or disables the automatic recovery policy: after a
+// crash the page is recovered with Recover. An unresponsive page is crashed
+// on purpose first, so that it gets a fresh renderer process.
+func (c *Chrome) SetAutoRecover(enabled bool) {
+	c.Lock()
+	c.crash.autoRecover = enabled
+	c.Unlock()
+}
+
+func (c *Chrome) crashed(kind CrashKind) {
+	c.Lock()
+	handlers := c.crash.handlers
+	autoRecover := c.crash.autoRecover
+	c.Unlock()
+	for _, handler := range handlers {
+		handler.f(kind)
+	}
+	if !autoRecover {
+		return
+	}
+	if kind == CrashKindUnresponsive {
+		// Page.crash is handled off the main thread, so it works even if the
+		// page is stuck. The resulting Inspector.targetCrashed recovers it.
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		_, err := c.SendContext(ctx, "Page.crash", nil)
+		cancel()
+		if err == nil || err == context.DeadlineExceeded {
+			return
+		}
+	}
+	if err := c.Recover(); err != nil {
+		c.reportError("recovering the page after it has " + kind.String() + " failed: " + err.Error())
+	}
+}
+
+// Recover brings a crashed page back: it installs all the bindings and
+// scripts registered with Bind and AddScriptToEvaluateOnNewDocument again in a
+// blank page, then loads the last URL.
+func (c *Chrome) Recover() error {
+	c.Lock()
+	scripts := append([]*script{}, c.scripts...)
+	names := []string{}
+	for name := range c.bindings {
+		names = append(names, name)
+	}
+	url := c.url
+	c.Unlock()
+	sort.Strings(names)
+
+	// A crashed page doesn't answer until a navigation starts a new renderer
+	// process, so it is loaded blank before the bindings are installed
+	if _, err := c.Send("Page.navigate", h{"url": "about:blank"}); err != nil {
+		return err
+	}
+	for _, name := range names {
+		if _, err := c.Send("Runtime.addBinding", h{"name": name}); err != nil {
+			return err
+		}
+	}
+	for _, s := range scripts {
+		// Registered scripts may survive a crash, don't run them twice
+		c.Send("Page.removeScriptToEvaluateOnNewDocument", h{"identifier": s.id})
+		if err := c.registerScript(s); err != nil {
+			return err
+		}
+	}
+	if url == "" || url == "about:blank" {
+		return nil
+	}
+	_, err := c.Send("Page.navigate", h{"url": url})
+	return err
+}
+
+// Watchdog pings the page with a trivial Runtime.evaluate every interval. If
+// the page doesn't answer within timeout it is considered unresponsive and
+// OnCrash handlers are called, once per hang. The page is not pinged while a
+// JavaScript dialog is open, as it can't answer then. The returned function stops the
+// watchdog, it also stops when the browser is closed.
+func (c *Chrome) Watchdog(interval, timeout time.Duration) func() {
+	stop := make(chan struct{})
+	go func() {
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		hung := false
+		for {
+			select {
+			case <-stop:
+				return
+			case <-c.done:
+				return
+			case <-t.C:
+			}
+			if c.dialogOpen() {
+				hung = false
+				continue
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			_, err := c.SendRaw(ctx, "Runtime.evaluate", h{"expression": "0"})
+			cancel()
+			if err == ErrBrowserClosed {
+				return
+			} else if err == context.DeadlineExceeded {
+				// A dialog opened while waiting blocks the answer
+				if !hung && !c.dialogOpen() {
+					hung = true
+					go c.crashed(CrashKindUnresponsive)
+				}
+			} else {
+				hung = false
+			}
+		}
+	}()
+	return func() { close(stop) }
+}
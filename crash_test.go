@@ -0,0 +1,81 @@
+package lorca
+
+import (
+	"encoding/json"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// silentTransport counts the messages sent and never answers them. If err
+// is set, sending fails with it.
+type silentTransport struct {
+	sent   int32
+	err    error
+	closed chan struct{}
+}
+
+func (t *silentTransport) Send(msg []byte) error {
+	atomic.AddInt32(&t.sent, 1)
+	return t.err
+}
+
+func (t *silentTransport) Receive() ([]byte, error) {
+	<-t.closed
+	return nil, errors.New("closed")
+}
+
+func (t *silentTransport) Close() error { return nil }
+
+func TestWatchdogDialog(t *testing.T) {
+	tr := &silentTransport{closed: make(chan struct{})}
+	defer close(tr.closed)
+	c := newChrome()
+	c.conn = tr
+	c.watchCrashes()
+	crashes := make(chan CrashKind, 1)
+	c.OnCrash(func(kind CrashKind) { crashes <- kind })
+
+	c.emit("Page.javascriptDialogOpening", json.RawMessage(`{"type":"alert","message":"hi"}`))
+	stop := c.Watchdog(5*time.Millisecond, 5*time.Millisecond)
+	defer stop()
+	select {
+	case kind := <-crashes:
+		t.Fatal("page with an open dialog reported as", kind)
+	case <-time.After(50 * time.Millisecond):
+	}
+	if n := atomic.LoadInt32(&tr.sent); n != 0 {
+		t.Fatal("page with an open dialog pinged", n)
+	}
+
+	c.emit("Page.javascriptDialogClosed", json.RawMessage(`{"result":true,"userInput":""}`))
+	select {
+	case kind := <-crashes:
+		if kind != CrashKindUnresponsive {
+			t.Fatal(kind)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("unresponsive page not detected")
+	}
+}
+
+func TestRecoverError(t *testing.T) {
+	c := newChrome()
+	c.conn = &silentTransport{closed: make(chan struct{})}
+	messages := make(chan ConsoleMessage, 1)
+	c.SetConsoleHandler(ChanConsole(messages))
+	c.SetAutoRecover(true)
+	c.bindings["add"] = func(args []json.RawMessage) (interface{}, error) { return nil, nil }
+	// The browser is gone, so the binding can't be installed again
+	c.shutdown(CloseReasonKilled)
+	c.crashed(CrashKindCrashed)
+	select {
+	case m := <-messages:
+		if m.Level != ConsoleLevelError || m.Source != "lorca" || m.Text != "recovering the page after it has crashed failed: "+ErrBrowserClosed.Error() {
+			t.Fatal(m)
+		}
+	default:
+		t.Fatal("recover error is not reported")
+	}
+}
@@ -0,0 +1,97 @@
+package lorca
+
+import "encoding/json"
+
+// DialogType is the type of a JavaScript dialog
+type DialogType string
+
+const (
+	// DialogAlert is opened by alert()
+	DialogAlert DialogType = "alert"
+	// DialogConfirm is opened by confirm()
+	DialogConfirm DialogType = "confirm"
+	// DialogPrompt is opened by prompt()
+	DialogPrompt DialogType = "prompt"
+	// DialogBeforeUnload asks whether to leave a page with a beforeunload
+	// handler
+	DialogBeforeUnload DialogType = "beforeunload"
+)
+
+// Dialog is a JavaScript dialog opened by the page
+type Dialog struct {
+	Type    DialogType
+	Message string
+	// DefaultPrompt is the default text of a prompt dialog
+	DefaultPrompt string
+	// URL is the URL of the frame that opened the dialog
+	URL string
+}
+
+// DialogHandler answers a dialog. Accept confirms the dialog, text is the
+// answer to a prompt dialog.
+type DialogHandler func(d Dialog) (accept bool, text string)
+
+type dialogHandler struct {
+	id      int
+	handler DialogHandler
+}
+
+// DefaultDialogHandler accepts alert and beforeunload dialogs and dismisses
+// confirm and prompt dialogs. It answers the dialogs of headless browsers
+// started by lorca if no other handler is set, as they would block the page
+// forever.
+func DefaultDialogHandler(d Dialog) (bool, string) {
+	return d.Type == DialogAlert || d.Type == DialogBeforeUnload, ""
+}
+
+// OnDialog answers the JavaScript dialogs opened by the page with the handler,
+// which is called in a new goroutine. The returned function removes it, after
+// that dialogs are shown to the user again.
+func (c *Chrome) OnDialog(handler DialogHandler) func() {
+	c.Lock()
+	defer c.Unlock()
+	c.lastID++
+	id := c.lastID
+	c.dialog = dialogHandler{id: id, handler: handler}
+	return func() {
+		c.Lock()
+		defer c.Unlock()
+		if c.dialog.id == id {
+			c.dialog = dialogHandler{}
+		}
+	}
+}
+
+// watchDialogs passes the dialogs opened by the page to the dialog handler.
+// It must be called before the read loop is started.
+func (c *Chrome) watchDialogs() {
+	c.On("Page.javascriptDialogOpening", func(params json.RawMessage) {
+		e := struct {
+			URL           string     `json:"url"`
+			Message       string     `json:"message"`
+			Type          DialogType `json:"type"`
+			DefaultPrompt string     `json:"defaultPrompt"`
+		}{}
+		if json.Unmarshal(params, &e) != nil {
+			return
+		}
+		c.Lock()
+		handler := c.dialog.handler
+		if handler == nil && c.headless {
+			handler = DefaultDialogHandler
+		}
+		c.Unlock()
+		if handler == nil {
+			return
+		}
+		d := Dialog{Type: e.Type, Message: e.Message, DefaultPrompt: e.DefaultPrompt, URL: e.URL}
+		go func() {
+			accept, text := handler(d)
+			params := h{"accept": accept}
+			if d.Type == DialogPrompt && accept {
+				params["promptText"] = text
+			}
+			c.Send("Page.handleJavaScriptDialog", params)
+		}()
+	})
+}
@@ -0,0 +1,49 @@
+package lorca
+
+import "testing"
+
+func TestReplayDialog(t *testing.T) {
+	c := replay(t, "testdata/dialog.jsonl")
+	defer c.Kill()
+
+	off := c.OnDialog(func(d Dialog) (bool, string) {
+		if d.Type != DialogPrompt || d.Message != "Name?" || d.DefaultPrompt != "Bob" || d.URL != "about:blank" {
+			t.Error(d)
+		}
+		return true, "Ann"
+	})
+	if res, err := c.Eval(`prompt('Name?', 'Bob')`); err != nil || string(res) != `"Ann"` {
+		t.Fatal(string(res), err)
+	}
+	off()
+
+	// Without a handler, headless browsers dismiss confirm dialogs
+	c.Lock()
+	c.headless = true
+	c.Unlock()
+	if res, err := c.Eval(`confirm('Leave?')`); err != nil || string(res) != `false` {
+		t.Fatal(string(res), err)
+	}
+}
+
+func TestDefaultDialogHandler(t *testing.T) {
+	for typ, accept := range map[DialogType]bool{
+		DialogAlert:        true,
+		DialogBeforeUnload: true,
+		DialogConfirm:      false,
+		DialogPrompt:       false,
+	} {
+		if a, text := DefaultDialogHandler(Dialog{Type: typ, DefaultPrompt: "x"}); a != accept || text != "" {
+			t.Fatal(typ, a, text)
+		}
+	}
+}
+
+func TestIsHeadless(t *testing.T) {
+	if !isHeadless([]string{"--no-first-run", "--headless"}) || !isHeadless([]string{"--headless=new"}) {
+		t.Fatal("headless arguments are not recognized")
+	}
+	if isHeadless([]string{"--app=data:text/html,headless", "--headlessx"}) {
+		t.Fatal("non-headless arguments are recognized")
+	}
+}
@@ -0,0 +1,344 @@
+package lorca
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// ErrDownloadCanceled is returned by Download.Wait if the download has been
+// canceled, by the page, the user or Download.Cancel
+var ErrDownloadCanceled = errors.New("download canceled")
+
+// Download is a file being downloaded by the page
+type Download struct {
+	URL string
+	// SuggestedFilename is the file name proposed by the server or the page
+	SuggestedFilename string
+
+	sync.Mutex
+	c        *Chrome
+	guid     string
+	dir      string        // directory the browser downloads to
+	ready    chan struct{} // closed when the download handler has returned
+	done     chan struct{} // closed when the download has finished
+	ending   bool          // set when the download has finished, guarded by c
+	target   string
+	progress func(received, total int64)
+	path     string
+	err      error
+}
+
+type downloadState struct {
+	handler func(*Download)
+	dir     string
+	// active are the downloads started with a handler, until they are done.
+	// Directories replaced by a newer handler are removed once none of them
+	// uses it anymore.
+	active map[string]*Download
+}
+
+// OnDownload handles the files downloaded by the page. The handler is called
+// in a new goroutine when a download begins, and may choose the target path,
+// watch the progress or cancel the download. Downloads go to the user's
+// Downloads directory by default. The returned function restores the default
+// download behaviour of the browser.
+func (c *Chrome) OnDownload(handler func(*Download)) (func(), error) {
+	dir, err := ioutil.TempDir("", "lorca-download")
+	if err != nil {
+		return nil, err
+	}
+	c.Lock()
+	prev, prevHandler := c.download.dir, c.download.handler
+	c.download.handler = handler
+	c.download.dir = dir
+	c.Unlock()
+	// Downloads are named by their GUID, so that they can be found once
+	// finished
+	if _, err := c.sendBrowser("Browser.setDownloadBehavior", h{
+		"behavior":      "allowAndName",
+		"downloadPath":  dir,
+		"eventsEnabled": true,
+	}); err != nil {
+		c.Lock()
+		if c.download.dir == dir {
+			c.download.dir, c.download.handler = prev, prevHandler
+		}
+		c.Unlock()
+		os.RemoveAll(dir)
+		return nil, err
+	}
+	c.retireDownloadDir(prev)
+	return func() {
+		c.Lock()
+		if c.download.dir != dir {
+			c.Unlock()
+			return
+		}
+		c.download.handler = nil
+		c.download.dir = ""
+		c.Unlock()
+		c.sendBrowser("Browser.setDownloadBehavior", h{"behavior": "default"})
+		c.retireDownloadDir(dir)
+	}, nil
+}
+
+// downloadDirUsed tells whether an active download is in dir, c must be locked
+func (c *Chrome) downloadDirUsed(dir string) bool {
+	if dir == c.download.dir {
+		return true
+	}
+	for _, d := range c.download.active {
+		if d.dir == dir {
+			return true
+		}
+	}
+	return false
+}
+
+// retireDownloadDir removes a download directory that is no longer used by
+// the browser, unless downloads in it are still going on
+func (c *Chrome) retireDownloadDir(dir string) {
+	if dir == "" {
+		return
+	}
+	c.Lock()
+	used := c.downloadDirUsed(dir)
+	c.Unlock()
+	if !used {
+		os.RemoveAll(dir)
+	}
+}
+
+// downloadDone forgets a finished download, and removes its directory if it
+// has been the last one in a retired directory
+func (c *Chrome) downloadDone(d *Download) {
+	c.Lock()
+	delete(c.download.active, d.guid)
+	used := c.downloadDirUsed(d.dir)
+	c.Unlock()
+	if !used {
+		os.RemoveAll(d.dir)
+	}
+}
+
+// closeDownloads fails the active downloads and removes the download
+// directories once the browser is gone
+func (c *Chrome) closeDownloads() {
+	c.Lock()
+	dir := c.download.dir
+	c.download.handler = nil
+	c.download.dir = ""
+	failed := []*Download{}
+	for _, d := range c.download.active {
+		if !d.ending {
+			d.ending = true
+			failed = append(failed, d)
+		}
+	}
+	c.Unlock()
+	for _, d := range failed {
+		c.downloadDone(d)
+		d.end("", ErrBrowserClosed)
+	}
+	c.retireDownloadDir(dir)
+}
+
+// watchDownloads tracks the downloads started while a download handler is
+// set. It must be called before the read loop is started.
+func (c *Chrome) watchDownloads() {
+	c.On("Browser.downloadWillBegin", func(params json.RawMessage) {
+		e := struct {
+			GUID              string `json:"guid"`
+			URL               string `json:"url"`
+			SuggestedFilename string `json:"suggestedFilename"`
+		}{}
+		if json.Unmarshal(params, &e) != nil {
+			return
+		}
+		c.Lock()
+		handler := c.download.handler
+		d := &Download{
+			URL:               e.URL,
+			SuggestedFilename: e.SuggestedFilename,
+			c:                 c,
+			guid:              e.GUID,
+			dir:               c.download.dir,
+			ready:             make(chan struct{}),
+			done:              make(chan struct{}),
+		}
+		if handler != nil {
+			if c.download.active == nil {
+				c.download.active = map[string]*Download{}
+			}
+			c.download.active[e.GUID] = d
+		}
+		c.Unlock()
+		if handler != nil {
+			go func() {
+				defer close(d.ready)
+				handler(d)
+			}()
+		}
+	})
+	c.On("Browser.downloadProgress", func(params json.RawMessage) {
+		e := struct {
+			GUID          string  `json:"guid"`
+			TotalBytes    float64 `json:"totalBytes"`
+			ReceivedBytes float64 `json:"receivedBytes"`
+			State         string  `json:"state"`
+		}{}
+		if json.Unmarshal(params, &e) != nil {
+			return
+		}
+		c.Lock()
+		d, ok := c.download.active[e.GUID]
+		ending := ok && d.ending
+		if ok && e.State != "inProgress" {
+			d.ending = true
+		}
+		c.Unlock()
+		if !ok || ending {
+			return
+		}
+		d.Lock()
+		progress := d.progress
+		d.Unlock()
+		if progress != nil {
+			progress(int64(e.ReceivedBytes), int64(e.TotalBytes))
+		}
+		switch e.State {
+		case "completed":
+			go func() {
+				path, err := d.finish()
+				c.downloadDone(d)
+				d.end(path, err)
+			}()
+		case "canceled":
+			c.downloadDone(d)
+			d.end("", ErrDownloadCanceled)
+		}
+	})
+}
+
+// SaveAs sets the path the file is saved to once the download has finished.
+// It must be called before that, ideally from the download handler.
+func (d *Download) SaveAs(path string) {
+	d.Lock()
+	defer d.Unlock()
+	d.target = path
+}
+
+// OnProgress sets a function that is called with the number of bytes
+// received so far and the total size, 0 if unknown. It is called from the
+// read loop, so it must not block.
+func (d *Download) OnProgress(f func(received, total int64)) {
+	d.Lock()
+	defer d.Unlock()
+	d.progress = f
+}
+
+// Cancel cancels the download
+func (d *Download) Cancel() error {
+	_, err := d.c.sendBrowser("Browser.cancelDownload", h{"guid": d.guid})
+	return err
+}
+
+// Done returns a channel that is closed when the download has finished or
+// failed
+func (d *Download) Done() <-chan struct{} {
+	return d.done
+}
+
+// Wait waits until the download has finished and returns the path of the
+// saved file, or ErrBrowserClosed if the browser has gone away before. It must
+// not be called from the download handler.
+func (d *Download) Wait() (string, error) {
+	<-d.done
+	d.Lock()
+	defer d.Unlock()
+	return d.path, d.err
+}
+
+func (d *Download) end(path string, err error) {
+	d.Lock()
+	d.path, d.err = path, err
+	d.Unlock()
+	close(d.done)
+}
+
+// finish moves the downloaded file to its target path, once the handler has
+// had a chance to choose it
+func (d *Download) finish() (string, error) {
+	<-d.ready
+	d.Lock()
+	target := d.target
+	d.Unlock()
+	var err error
+	if target == "" {
+		target, err = defaultDownloadPath(d.SuggestedFilename)
+	}
+	if err == nil {
+		err = moveFile(filepath.Join(d.dir, d.guid), target)
+	}
+	return target, err
+}
+
+// defaultDownloadPath returns an unused path for the file in the user's
+// Downloads directory, or in the temporary directory if there is none
+func defaultDownloadPath(name string) (string, error) {
+	dir := os.TempDir()
+	if home, err := os.UserHomeDir(); err == nil {
+		if fi, err := os.Stat(filepath.Join(home, "Downloads")); err == nil && fi.IsDir() {
+			dir = filepath.Join(home, "Downloads")
+		}
+	}
+	name = filepath.Base(name)
+	if name == "." || name == string(filepath.Separator) {
+		name = "download"
+	}
+	ext := filepath.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+	path := filepath.Join(dir, name)
+	for i := 1; ; i++ {
+		if _, err := os.Lstat(path); os.IsNotExist(err) {
+			return path, nil
+		} else if err != nil {
+			return "", err
+		}
+		path = filepath.Join(dir, fmt.Sprintf("%s (%d)%s", base, i, ext))
+	}
+}
+
+// moveFile renames a file, or copies it if it is moved to another device
+func moveFile(src, dst string) error {
+	if err := os.Rename(src, dst); err == nil {
+		return nil
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		os.Remove(dst)
+		return err
+	}
+	if err := out.Close(); err != nil {
+		os.Remove(dst)
+		return err
+	}
+	in.Close()
+	return os.Remove(src)
+}
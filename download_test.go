@@ -0,0 +1,146 @@
+package lorca
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestDownloadEvents(t *testing.T) {
+	c := newChrome()
+	c.watchDownloads()
+	dir := t.TempDir()
+	target := filepath.Join(t.TempDir(), "report.csv")
+
+	downloads := make(chan *Download, 2)
+	progress := make(chan int64, 10)
+	c.download.dir = dir
+	c.download.handler = func(d *Download) {
+		if d.SuggestedFilename == "report.csv" {
+			d.SaveAs(target)
+			d.OnProgress(func(received, total int64) {
+				if total != 10 {
+					t.Error(total)
+				}
+				progress <- received
+			})
+		}
+		downloads <- d
+	}
+
+	emit := func(method string, params h) {
+		b, _ := json.Marshal(params)
+		c.emit(method, b)
+	}
+	emit("Browser.downloadWillBegin", h{"guid": "g1", "url": "https://app.test/report", "suggestedFilename": "report.csv"})
+	d := <-downloads
+	if d.URL != "https://app.test/report" {
+		t.Fatal(d.URL)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "g1"), []byte("a,b\n1,2\n3,4"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	emit("Browser.downloadProgress", h{"guid": "g1", "totalBytes": 10, "receivedBytes": 4, "state": "inProgress"})
+	emit("Browser.downloadProgress", h{"guid": "g1", "totalBytes": 10, "receivedBytes": 10, "state": "completed"})
+	if r := <-progress; r != 4 {
+		t.Fatal(r)
+	}
+	if r := <-progress; r != 10 {
+		t.Fatal(r)
+	}
+	if path, err := d.Wait(); err != nil || path != target {
+		t.Fatal(path, err)
+	}
+	if b, err := ioutil.ReadFile(target); err != nil || string(b) != "a,b\n1,2\n3,4" {
+		t.Fatal(string(b), err)
+	}
+
+	emit("Browser.downloadWillBegin", h{"guid": "g2", "url": "https://app.test/big", "suggestedFilename": "big.zip"})
+	d = <-downloads
+	emit("Browser.downloadProgress", h{"guid": "g2", "totalBytes": 0, "receivedBytes": 0, "state": "canceled"})
+	select {
+	case <-d.Done():
+	case <-time.After(time.Second):
+		t.Fatal("canceled download has not finished")
+	}
+	if _, err := d.Wait(); err != ErrDownloadCanceled {
+		t.Fatal(err)
+	}
+	if len(c.download.active) != 0 {
+		t.Fatal(c.download.active)
+	}
+}
+
+func TestDownloadDirs(t *testing.T) {
+	c := newChrome()
+	c.watchDownloads()
+	downloads := make(chan *Download, 2)
+	old, cur := filepath.Join(t.TempDir(), "old"), filepath.Join(t.TempDir(), "cur")
+	for _, dir := range []string{old, cur} {
+		if err := os.Mkdir(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	c.download.dir = old
+	c.download.handler = func(d *Download) { downloads <- d }
+
+	emit := func(method string, params h) {
+		b, _ := json.Marshal(params)
+		c.emit(method, b)
+	}
+	emit("Browser.downloadWillBegin", h{"guid": "g1", "url": "https://app.test/a", "suggestedFilename": "a.txt"})
+	d := <-downloads
+	d.SaveAs(filepath.Join(t.TempDir(), "a.txt"))
+	if err := ioutil.WriteFile(filepath.Join(old, "g1"), []byte("a"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// A new handler must not remove the directory of a running download
+	c.download.dir = cur
+	c.retireDownloadDir(old)
+	if _, err := os.Stat(old); err != nil {
+		t.Fatal(err)
+	}
+	emit("Browser.downloadProgress", h{"guid": "g1", "totalBytes": 1, "receivedBytes": 1, "state": "completed"})
+	if _, err := d.Wait(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(old); !os.IsNotExist(err) {
+		t.Fatal("retired directory has not been removed", err)
+	}
+
+	// Closing the browser fails running downloads and removes the directory
+	emit("Browser.downloadWillBegin", h{"guid": "g2", "url": "https://app.test/b", "suggestedFilename": "b.txt"})
+	d = <-downloads
+	c.shutdown(CloseReasonKilled)
+	if _, err := d.Wait(); err != ErrBrowserClosed {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(cur); !os.IsNotExist(err) {
+		t.Fatal("download directory has not been removed", err)
+	}
+}
+
+func TestOnDownloadFailure(t *testing.T) {
+	tr := &silentTransport{err: errors.New("send failed"), closed: make(chan struct{})}
+	defer close(tr.closed)
+	c := newChrome()
+	c.conn = tr
+	prev := t.TempDir()
+	c.download.dir = prev
+	c.download.handler = func(d *Download) {}
+
+	if _, err := c.OnDownload(func(d *Download) {}); err == nil || err.Error() != "send failed" {
+		t.Fatal(err)
+	}
+	if c.download.dir != prev {
+		t.Fatal("previous download directory not restored", c.download.dir)
+	}
+	if _, err := os.Stat(prev); err != nil {
+		t.Fatal(err)
+	}
+}
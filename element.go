@@ -0,0 +1,211 @@
+package lorca
+
+import (
+	"encoding/json"
+	"errors"
+	"path/filepath"
+)
+
+// Element is a DOM element of the page. It is a handle, so it can be passed
+// to Call and must be released when no longer needed.
+type Element struct {
+	*JSHandle
+}
+
+// Rect is a rectangle in CSS pixels
+type Rect struct {
+	X      float64 `json:"x"`
+	Y      float64 `json:"y"`
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+}
+
+// Query returns the first element matching the CSS selector, or nil if there
+// is none
+func (c *Chrome) Query(selector string) (*Element, error) {
+	root, err := c.documentNode()
+	if err != nil {
+		return nil, err
+	}
+	raw, err := c.Send("DOM.querySelector", h{"nodeId": root, "selector": selector})
+	if err != nil {
+		return nil, err
+	}
+	res := struct {
+		NodeID int `json:"nodeId"`
+	}{}
+	if err := json.Unmarshal(raw, &res); err != nil {
+		return nil, err
+	} else if res.NodeID == 0 {
+		return nil, nil
+	}
+	return c.resolveNode(h{"nodeId": res.NodeID})
+}
+
+// QueryAll returns all the elements matching the CSS selector in document
+// order
+func (c *Chrome) QueryAll(selector string) ([]*Element, error) {
+	root, err := c.documentNode()
+	if err != nil {
+		return nil, err
+	}
+	raw, err := c.Send("DOM.querySelectorAll", h{"nodeId": root, "selector": selector})
+	if err != nil {
+		return nil, err
+	}
+	res := struct {
+		NodeIDs []int `json:"nodeIds"`
+	}{}
+	if err := json.Unmarshal(raw, &res); err != nil {
+		return nil, err
+	}
+	elements := []*Element{}
+	for _, id := range res.NodeIDs {
+		e, err := c.resolveNode(h{"nodeId": id})
+		if err != nil {
+			for _, e := range elements {
+				e.Release()
+			}
+			return nil, err
+		}
+		elements = append(elements, e)
+	}
+	return elements, nil
+}
+
+// documentNode returns the node ID of the document. Node IDs are only valid
+// until the next DOM.getDocument, so it is requested for every query.
+func (c *Chrome) documentNode() (int, error) {
+	raw, err := c.Send("DOM.getDocument", h{"depth": 0})
+	if err != nil {
+		return 0, err
+	}
+	res := struct {
+		Root struct {
+			NodeID int `json:"nodeId"`
+		} `json:"root"`
+	}{}
+	err = json.Unmarshal(raw, &res)
+	return res.Root.NodeID, err
+}
+
+// resolveNode returns the element of a DOM node, given by its "nodeId" or
+// "backendNodeId"
+func (c *Chrome) resolveNode(node h) (*Element, error) {
+	raw, err := c.Send("DOM.resolveNode", node)
+	if err != nil {
+		return nil, err
+	}
+	res := struct {
+		Object remoteObject `json:"object"`
+	}{}
+	if err := json.Unmarshal(raw, &res); err != nil {
+		return nil, err
+	}
+	return &Element{&JSHandle{c: c, object: res.Object}}, nil
+}
+
+func (e *Element) callString(fn string, args ...interface{}) (string, error) {
+	raw, err := e.callValue(fn, args...)
+	if err != nil {
+		return "", err
+	}
+	s := ""
+	err = json.Unmarshal(raw, &s)
+	return s, err
+}
+
+// Text returns the rendered text of the element, its innerText
+func (e *Element) Text() (string, error) {
+	return e.callString(`function() { return this.innerText; }`)
+}
+
+// HTML returns the HTML of the element including itself, its outerHTML
+func (e *Element) HTML() (string, error) {
+	return e.callString(`function() { return this.outerHTML; }`)
+}
+
+// Attr returns the value of an attribute, or an empty string if the element
+// doesn't have it
+func (e *Element) Attr(name string) (string, error) {
+	return e.callString(`function(name) { return this.getAttribute(name) || ''; }`, name)
+}
+
+// BoundingBox returns the position and size of the element relative to the
+// viewport
+func (e *Element) BoundingBox() (Rect, error) {
+	return e.rect(`function() {
+		const r = this.getBoundingClientRect();
+		return {x: r.x, y: r.y, width: r.width, height: r.height};
+	}`)
+}
+
+func (e *Element) rect(fn string, args ...interface{}) (Rect, error) {
+	r := Rect{}
+	raw, err := e.callValue(fn, args...)
+	if err != nil {
+		return r, err
+	}
+	err = json.Unmarshal(raw, &r)
+	return r, err
+}
+
+// scrollIntoView scrolls the element into view and returns its box relative
+// to the viewport, or to the page if page is true
+func (e *Element) scrollIntoView(page bool) (Rect, error) {
+	r, err := e.rect(`function(page) {
+		this.scrollIntoViewIfNeeded ? this.scrollIntoViewIfNeeded(true) : this.scrollIntoView({block: 'center'});
+		const r = this.getBoundingClientRect();
+		const dx = page ? window.scrollX : 0, dy = page ? window.scrollY : 0;
+		return {x: r.x + dx, y: r.y + dy, width: r.width, height: r.height};
+	}`, page)
+	if err == nil && (r.Width == 0 || r.Height == 0) {
+		err = errors.New("element is not visible")
+	}
+	return r, err
+}
+
+// Click scrolls the element into view and clicks its center with the left
+// mouse button
+func (e *Element) Click() error {
+	r, err := e.scrollIntoView(false)
+	if err != nil {
+		return err
+	}
+	return e.c.mouse.Click(r.X+r.Width/2, r.Y+r.Height/2, MouseLeft)
+}
+
+// Focus focuses the element
+func (e *Element) Focus() error {
+	_, err := e.c.Send("DOM.focus", h{"objectId": e.object.ObjectID})
+	return err
+}
+
+// Type focuses the element and types the text into it with the keyboard
+func (e *Element) Type(text string) error {
+	if err := e.Focus(); err != nil {
+		return err
+	}
+	return e.c.keyboard.Type(text)
+}
+
+// Screenshot scrolls the element into view and returns a PNG image of it,
+// see Chrome.Screenshot for more options
+func (e *Element) Screenshot() ([]byte, error) {
+	return e.c.Screenshot(&ScreenshotOptions{Element: e})
+}
+
+// SetFiles sets the files of a file input element, like a user choosing them
+// in a file dialog
+func (e *Element) SetFiles(paths ...string) error {
+	files := []string{}
+	for _, path := range paths {
+		abs, err := filepath.Abs(path)
+		if err != nil {
+			return err
+		}
+		files = append(files, abs)
+	}
+	_, err := e.c.Send("DOM.setFileInputFiles", h{"files": files, "objectId": e.object.ObjectID})
+	return err
+}
@@ -3,9 +3,7 @@
 package main
 
 import (
-	"fmt"
 	"log"
-	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -60,13 +58,12 @@ func main() {
 	// You may also use `data:text/html,<base64>` approach to load initial HTML,
 	// e.g: ui.Load("data:text/html," + url.PathEscape(html))
 
-	ln, err := net.Listen("tcp", "127.0.0.1:0")
-	if err != nil {
+	// Assets are served straight from Go to the browser, without opening a
+	// port that other local processes could connect to.
+	if _, err := ui.ServeHandler("https://counter.lorca", http.FileServer(FS)); err != nil {
 		log.Fatal(err)
 	}
-	defer ln.Close()
-	go http.Serve(ln, http.FileServer(FS))
-	ui.Load(fmt.Sprintf("http://%s", ln.Addr()))
+	ui.Load("https://counter.lorca/")
 
 	// You may use console.log to debug your JS code, it will be printed via
 	// log.Println(). Also exceptions are printed in a similar manner.
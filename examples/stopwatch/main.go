@@ -1,7 +1,6 @@
 package main
 
 import (
-	"fmt"
 	"log"
 	"net/url"
 	"sync/atomic"
@@ -45,8 +44,8 @@ func main() {
 		for {
 			select {
 			case <-t.C: // Every 100ms increate number of ticks and update UI
-				ui.Eval(fmt.Sprintf(`document.querySelector('.timer').innerText = 0.1*%d`,
-					atomic.AddUint32(&ticks, 1)))
+				ui.Call(`n => document.querySelector('.timer').innerText = 0.1*n`,
+					atomic.AddUint32(&ticks, 1))
 			case <-togglec: // If paused - wait for another toggle event to unpause
 				<-togglec
 			}
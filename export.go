@@ -50,7 +50,7 @@ func doHeadless(url string, f func(c *Chrome) ([]byte, error)) ([]byte, error) {
 		return nil, err
 	}
 	defer os.RemoveAll(dir)
-	args := append(defaultChromeArgs, fmt.Sprintf("--user-data-dir=%s", dir), "--remote-debugging-port=0", "--headless", url)
+	args := append(defaultChromeArgs, fmt.Sprintf("--user-data-dir=%s", dir), remoteDebuggingArg(), "--headless", url)
 	chrome, err := NewChromeWithArgs(LocateChrome(), args...)
 	if err != nil {
 		return nil, err
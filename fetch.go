@@ -0,0 +1,179 @@
+package lorca
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+)
+
+// pausedRequest is a request intercepted by the Fetch domain,
+// Fetch.requestPaused
+type pausedRequest struct {
+	RequestID string `json:"requestId"`
+	NetworkID string `json:"networkId"`
+	Request   struct {
+		URL             string            `json:"url"`
+		URLFragment     string            `json:"urlFragment"`
+		Method          string            `json:"method"`
+		Headers         map[string]string `json:"headers"`
+		PostData        string            `json:"postData"`
+		HasPostData     bool              `json:"hasPostData"`
+		PostDataEntries []struct {
+			Bytes []byte `json:"bytes"`
+		} `json:"postDataEntries"`
+	} `json:"request"`
+	ResourceType string `json:"resourceType"`
+}
+
+// route answers intercepted requests whose URL matches
+type route struct {
+	id int
+	// patterns are Fetch.RequestPattern URL patterns, globs where * matches
+	// any string and ? any character
+	patterns []string
+	match    func(url string) bool
+	handle   func(req *pausedRequest) error
+}
+
+type fetchState struct {
+	sync.Mutex // serializes Fetch.enable calls
+	routes     []route
+}
+
+// watchFetch answers intercepted requests. It must be called before the read
+// loop is started.
+func (c *Chrome) watchFetch() {
+	c.On("Fetch.requestPaused", func(params json.RawMessage) {
+		req := &pausedRequest{}
+		if err := json.Unmarshal(params, req); err != nil {
+			return
+		}
+		go c.routeRequest(req)
+	})
+}
+
+// routeRequest passes a request to the most recently added matching route,
+// requests that no route matches continue to the network
+func (c *Chrome) routeRequest(req *pausedRequest) {
+	c.Lock()
+	routes := c.fetch.routes
+	c.Unlock()
+	for i := len(routes) - 1; i >= 0; i-- {
+		if routes[i].match(req.Request.URL) {
+			if err := routes[i].handle(req); err != nil {
+				c.Send("Fetch.failRequest", h{"requestId": req.RequestID, "errorReason": "Failed"})
+			}
+			return
+		}
+	}
+	c.Send("Fetch.continueRequest", h{"requestId": req.RequestID})
+}
+
+// addRoute starts intercepting requests for the route, the returned function
+// stops it
+func (c *Chrome) addRoute(r route) (func(), error) {
+	c.Lock()
+	c.lastID++
+	r.id = c.lastID
+	c.fetch.routes = append(c.fetch.routes, r)
+	c.Unlock()
+	if err := c.updateFetch(); err != nil {
+		c.removeRoute(r.id)
+		return nil, err
+	}
+	return func() {
+		c.removeRoute(r.id)
+		c.updateFetch()
+	}, nil
+}
+
+func (c *Chrome) removeRoute(id int) {
+	c.Lock()
+	defer c.Unlock()
+	routes := c.fetch.routes
+	for i := range routes {
+		if routes[i].id == id {
+			c.fetch.routes = append(routes[:i:i], routes[i+1:]...)
+			break
+		}
+	}
+}
+
+// updateFetch enables interception of the URL patterns of all the routes, or
+// disables the Fetch domain if there are none
+func (c *Chrome) updateFetch() error {
+	c.fetch.Lock()
+	defer c.fetch.Unlock()
+	c.Lock()
+	seen := map[string]bool{}
+	patterns := []string{}
+	for _, r := range c.fetch.routes {
+		for _, p := range r.patterns {
+			if !seen[p] {
+				seen[p] = true
+				patterns = append(patterns, p)
+			}
+		}
+	}
+	c.Unlock()
+	if len(patterns) == 0 {
+		_, err := c.Send("Fetch.disable", nil)
+		return err
+	}
+	sort.Strings(patterns)
+	requestPatterns := []h{}
+	for _, p := range patterns {
+		requestPatterns = append(requestPatterns, h{"urlPattern": p, "requestStage": "Request"})
+	}
+	_, err := c.Send("Fetch.enable", h{"patterns": requestPatterns})
+	return err
+}
+
+// fulfill answers an intercepted request. Headers are sorted, so that
+// responses are reproducible.
+func (c *Chrome) fulfill(req *pausedRequest, status int, headers map[string][]string, body []byte) error {
+	names := []string{}
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if body == nil {
+		body = []byte{}
+	}
+	responseHeaders := []h{}
+	for _, name := range names {
+		for _, value := range headers[name] {
+			responseHeaders = append(responseHeaders, h{"name": name, "value": value})
+		}
+	}
+	_, err := c.Send("Fetch.fulfillRequest", h{
+		"requestId":       req.RequestID,
+		"responseCode":    status,
+		"responseHeaders": responseHeaders,
+		"body":            body,
+	})
+	return err
+}
+
+// postData returns the body of an intercepted request
+func (c *Chrome) postData(req *pausedRequest) ([]byte, error) {
+	if len(req.Request.PostDataEntries) > 0 {
+		body := []byte{}
+		for _, e := range req.Request.PostDataEntries {
+			body = append(body, e.Bytes...)
+		}
+		return body, nil
+	} else if req.Request.PostData != "" || !req.Request.HasPostData {
+		return []byte(req.Request.PostData), nil
+	}
+	// Large bodies are not sent with the event
+	raw, err := c.Send("Network.getRequestPostData", h{"requestId": req.NetworkID})
+	if err != nil {
+		return nil, err
+	}
+	res := struct {
+		PostData string `json:"postData"`
+	}{}
+	err = json.Unmarshal(raw, &res)
+	return []byte(res.PostData), err
+}
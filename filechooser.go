@@ -0,0 +1,98 @@
+package lorca
+
+import "encoding/json"
+
+// FileChooserMode tells whether a file input accepts one or more files
+type FileChooserMode string
+
+const (
+	// FileChooserSingle is the mode of a file input that accepts one file
+	FileChooserSingle FileChooserMode = "selectSingle"
+	// FileChooserMultiple is the mode of a file input with the multiple
+	// attribute
+	FileChooserMultiple FileChooserMode = "selectMultiple"
+)
+
+// FileChooserHandler chooses the files for a file input, given its mode and
+// accept attribute, e.g. "image/*,.pdf". Returning no files or an error leaves
+// the input unchanged, like canceling the dialog. Failures to fill the input
+// are reported to the console handler.
+type FileChooserHandler func(mode FileChooserMode, accept string) ([]string, error)
+
+type fileChooser struct {
+	id      int
+	handler FileChooserHandler
+}
+
+// OnFileChooser replaces the file dialog of the browser with the handler, so
+// that the app can show its own dialog, or provide fixed paths in tests. The
+// handler is called in a new goroutine. The returned function restores the
+// browser dialog.
+func (c *Chrome) OnFileChooser(handler FileChooserHandler) (func(), error) {
+	c.Lock()
+	c.lastID++
+	id := c.lastID
+	c.chooser = fileChooser{id: id, handler: handler}
+	c.Unlock()
+	off := func() {
+		c.Lock()
+		if c.chooser.id != id {
+			c.Unlock()
+			return
+		}
+		c.chooser = fileChooser{}
+		c.Unlock()
+		c.Send("Page.setInterceptFileChooserDialog", h{"enabled": false})
+	}
+	if _, err := c.Send("Page.setInterceptFileChooserDialog", h{"enabled": true}); err != nil {
+		off()
+		return nil, err
+	}
+	return off, nil
+}
+
+// watchFileChooser passes the intercepted file dialogs to the file chooser
+// handler. It must be called before the read loop is started.
+func (c *Chrome) watchFileChooser() {
+	c.On("Page.fileChooserOpened", func(params json.RawMessage) {
+		e := struct {
+			Mode          FileChooserMode `json:"mode"`
+			BackendNodeID int             `json:"backendNodeId"`
+		}{}
+		if json.Unmarshal(params, &e) != nil || e.BackendNodeID == 0 {
+			return
+		}
+		c.Lock()
+		handler := c.chooser.handler
+		c.Unlock()
+		if handler != nil {
+			go c.chooseFiles(handler, e.Mode, e.BackendNodeID)
+		}
+	})
+}
+
+// chooseFiles fills a file input with the files chosen by the handler. The
+// page has no way to learn about failures, they go to the console handler.
+func (c *Chrome) chooseFiles(handler FileChooserHandler, mode FileChooserMode, node int) {
+	if err := c.fillFileInput(handler, mode, node); err != nil {
+		c.reportError("choosing files for a file input failed: " + err.Error())
+	}
+}
+
+func (c *Chrome) fillFileInput(handler FileChooserHandler, mode FileChooserMode, node int) error {
+	input, err := c.resolveNode(h{"backendNodeId": node})
+	if err != nil {
+		return err
+	}
+	defer input.Release()
+	accept, err := input.Attr("accept")
+	if err != nil {
+		return err
+	}
+	files, err := handler(mode, accept)
+	if err != nil || len(files) == 0 {
+		// Like canceling the dialog
+		return nil
+	}
+	return input.SetFiles(files...)
+}
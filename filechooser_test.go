@@ -0,0 +1,69 @@
+package lorca
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestReplayFileChooser(t *testing.T) {
+	c := replay(t, "testdata/filechooser.jsonl")
+	defer c.Kill()
+
+	if _, err := c.ServeHandler("https://app.local", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<input id="photos" type="file" accept="image/*,.pdf" multiple>`))
+	})); err != nil {
+		t.Fatal(err)
+	}
+	off, err := c.OnFileChooser(func(mode FileChooserMode, accept string) ([]string, error) {
+		if mode != FileChooserMultiple || accept != "image/*,.pdf" {
+			t.Error(mode, accept)
+		}
+		return []string{"/tmp/a.png", "/tmp/b.pdf"}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer off()
+	if err := c.LoadAndWait("https://app.local/", WaitLoad, 5*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	input, err := c.Query("#photos")
+	if err != nil || input == nil {
+		t.Fatal(input, err)
+	}
+	if err := input.Click(); err != nil {
+		t.Fatal(err)
+	}
+	names, err := c.WaitForFunction(`(() => {
+		const files = document.querySelector('#photos').files;
+		return files.length > 0 && Array.from(files, f => f.name);
+	})()`, &WaitOptions{Timeout: 5 * time.Second})
+	if err != nil || string(names) != `["a.png","b.pdf"]` {
+		t.Fatal(string(names), err)
+	}
+}
+
+func TestChooseFilesError(t *testing.T) {
+	tr := &silentTransport{err: errors.New("send failed"), closed: make(chan struct{})}
+	defer close(tr.closed)
+	c := newChrome()
+	c.conn = tr
+	messages := make(chan ConsoleMessage, 1)
+	c.SetConsoleHandler(ChanConsole(messages))
+
+	c.chooseFiles(func(mode FileChooserMode, accept string) ([]string, error) {
+		t.Error("handler called without an input")
+		return nil, nil
+	}, FileChooserSingle, 5)
+	select {
+	case m := <-messages:
+		if m.Level != ConsoleLevelError || m.Source != "lorca" || m.Text != "choosing files for a file input failed: send failed" {
+			t.Fatal(m)
+		}
+	default:
+		t.Fatal("file chooser error is not reported")
+	}
+}
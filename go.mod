@@ -1,3 +1,5 @@
 module github.com/kjk/lorca
 
+go 1.15
+
 require golang.org/x/net v0.0.0-20181102091132-c10e9556a7bc
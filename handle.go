@@ -0,0 +1,179 @@
+package lorca
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// JSHandle is a reference to a JavaScript value that lives in the page, such
+// as a DOM node, a function or a Map, which can't be returned by value. A
+// handle keeps its value alive until it is released, either on its own with
+// Release or together with its object group. Handles can be passed as
+// arguments to Call and to the methods of other handles.
+type JSHandle struct {
+	c      *Chrome
+	group  string
+	object remoteObject
+}
+
+// ObjectGroup is a set of handles that are released together
+type ObjectGroup struct {
+	c    *Chrome
+	name string
+}
+
+// ObjectGroup returns the object group with the given name. Groups with the
+// same name are the same group.
+func (c *Chrome) ObjectGroup(name string) *ObjectGroup {
+	return &ObjectGroup{c: c, name: name}
+}
+
+// EvalHandle evaluates a JavaScript expression and returns a handle to its
+// result. The handle doesn't belong to any object group.
+func (c *Chrome) EvalHandle(expr string) (*JSHandle, error) {
+	return (&ObjectGroup{c: c}).Eval(expr)
+}
+
+// CallHandle is like Call, but returns a handle to the result. The handle
+// doesn't belong to any object group.
+func (c *Chrome) CallHandle(fn string, args ...interface{}) (*JSHandle, error) {
+	return (&ObjectGroup{c: c}).Call(fn, args...)
+}
+
+// Eval evaluates a JavaScript expression and returns a handle to its result
+// in the group
+func (g *ObjectGroup) Eval(expr string) (*JSHandle, error) {
+	params := h{"expression": expr, "awaitPromise": true}
+	if g.name != "" {
+		params["objectGroup"] = g.name
+	}
+	raw, err := g.c.SendRaw(context.Background(), "Runtime.evaluate", params)
+	if err != nil {
+		return nil, err
+	}
+	return g.handle(raw)
+}
+
+// Call calls a JavaScript function like Chrome.Call and returns a handle to
+// its result in the group
+func (g *ObjectGroup) Call(fn string, args ...interface{}) (*JSHandle, error) {
+	params := h{}
+	if g.name != "" {
+		params["objectGroup"] = g.name
+	}
+	raw, err := g.c.callGlobal(context.Background(), fn, params, args...)
+	if err != nil {
+		return nil, err
+	}
+	return g.handle(raw)
+}
+
+// Release releases all the handles of the group at once
+func (g *ObjectGroup) Release() error {
+	if g.name == "" {
+		return errors.New("handles without a group must be released one by one")
+	}
+	_, err := g.c.Send("Runtime.releaseObjectGroup", h{"objectGroup": g.name})
+	return err
+}
+
+func (g *ObjectGroup) handle(raw json.RawMessage) (*JSHandle, error) {
+	o, err := evalObject(raw)
+	if err != nil {
+		return nil, err
+	}
+	return &JSHandle{c: g.c, group: g.name, object: o}, nil
+}
+
+// Type returns the JavaScript type of the value, as returned by typeof
+func (j *JSHandle) Type() string { return j.object.Type }
+
+// Subtype returns the kind of an object value, like "node", "array", "map" or
+// "null", if any
+func (j *JSHandle) Subtype() string { return j.object.Subtype }
+
+// String returns a description of the value, like DevTools console shows it
+func (j *JSHandle) String() string { return j.object.format() }
+
+// GetProperty returns a handle to the value of a property, in the same object
+// group
+func (j *JSHandle) GetProperty(name string) (*JSHandle, error) {
+	return j.call(`function(name) { return this[name]; }`, name)
+}
+
+// Call calls a method of the value with the given arguments and returns a
+// handle to its result, in the same object group
+func (j *JSHandle) Call(method string, args ...interface{}) (*JSHandle, error) {
+	return j.call(`function(method, ...args) { return this[method](...args); }`,
+		append([]interface{}{method}, args...)...)
+}
+
+func (j *JSHandle) call(fn string, args ...interface{}) (*JSHandle, error) {
+	if j.object.ObjectID == "" {
+		return nil, errors.New("handle is not an object")
+	}
+	params := h{"objectId": j.object.ObjectID}
+	if j.group != "" {
+		params["objectGroup"] = j.group
+	}
+	raw, err := j.c.callFunction(context.Background(), fn, params, args...)
+	if err != nil {
+		return nil, err
+	}
+	return (&ObjectGroup{c: j.c, name: j.group}).handle(raw)
+}
+
+// callValue calls a function with the value as this and returns its result by
+// value
+func (j *JSHandle) callValue(fn string, args ...interface{}) (json.RawMessage, error) {
+	raw, err := j.c.callFunction(context.Background(), fn,
+		h{"objectId": j.object.ObjectID, "returnByValue": true}, args...)
+	if err != nil {
+		return nil, err
+	}
+	return evalValue(raw)
+}
+
+// JSONValue returns the value serialized as JSON. It fails for values that
+// can't be serialized, such as cyclic objects.
+func (j *JSHandle) JSONValue() (json.RawMessage, error) {
+	if j.object.ObjectID == "" {
+		if j.object.UnserializableValue != "" {
+			return nil, fmt.Errorf("%s can not be represented in JSON", j.object.UnserializableValue)
+		} else if j.object.Value == nil {
+			return json.RawMessage("null"), nil
+		}
+		return j.object.Value, nil
+	}
+	raw, err := j.c.callFunction(context.Background(), `function() { return this; }`,
+		h{"objectId": j.object.ObjectID, "returnByValue": true})
+	if err != nil {
+		return nil, err
+	}
+	o, err := evalObject(raw)
+	return o.Value, err
+}
+
+// Release releases the value, so that it can be garbage collected. The handle
+// must not be used afterwards.
+func (j *JSHandle) Release() error {
+	if j.object.ObjectID == "" {
+		return nil
+	}
+	_, err := j.c.Send("Runtime.releaseObject", h{"objectId": j.object.ObjectID})
+	return err
+}
+
+func (j *JSHandle) callArgument() (h, error) {
+	switch {
+	case j.object.ObjectID != "":
+		return h{"objectId": j.object.ObjectID}, nil
+	case j.object.UnserializableValue != "":
+		return h{"unserializableValue": j.object.UnserializableValue}, nil
+	case j.object.Type == "undefined":
+		return h{}, nil
+	}
+	return h{"value": j.object.Value}, nil
+}
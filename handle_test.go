@@ -0,0 +1,65 @@
+package lorca
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestReplayHandle(t *testing.T) {
+	c := replay(t, "testdata/handle.jsonl")
+	defer c.Kill()
+
+	g := c.ObjectGroup("test")
+	m, err := g.Eval(`new Map([[1, {a: 2}]])`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Type() != "object" || m.Subtype() != "map" || m.String() != "Map(1)" {
+		t.Fatal(m.Type(), m.Subtype(), m.String())
+	}
+	o, err := m.Call("get", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, err := o.GetProperty("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, err := a.JSONValue(); err != nil || string(v) != `2` {
+		t.Fatal(string(v), err)
+	}
+	if v, err := o.JSONValue(); err != nil || string(v) != `{"a":2}` {
+		t.Fatal(string(v), err)
+	}
+	if v, err := c.Call(`(m, n) => m.size + n`, m, 2); err != nil || string(v) != `3` {
+		t.Fatal(string(v), err)
+	}
+	if err := g.Release(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHandleCallArgument(t *testing.T) {
+	for _, test := range []struct {
+		Object string
+		Result string
+	}{
+		{Object: `{"type":"object","objectId":"1.2.3"}`, Result: `{"objectId":"1.2.3"}`},
+		{Object: `{"type":"number","value":42}`, Result: `{"value":42}`},
+		{Object: `{"type":"number","unserializableValue":"-0"}`, Result: `{"unserializableValue":"-0"}`},
+		{Object: `{"type":"object","subtype":"null","value":null}`, Result: `{"value":null}`},
+		{Object: `{"type":"undefined"}`, Result: `{}`},
+	} {
+		j := &JSHandle{}
+		if err := json.Unmarshal([]byte(test.Object), &j.object); err != nil {
+			t.Fatal(err)
+		}
+		a, err := callArgument(j)
+		if err != nil {
+			t.Fatal(test.Object, err)
+		}
+		if b, _ := json.Marshal(a); string(b) != test.Result {
+			t.Fatal(test.Object, string(b), test.Result)
+		}
+	}
+}
@@ -0,0 +1,266 @@
+package lorca
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// HAR 1.2 types, see http://www.softwareishard.com/blog/har-12-spec/
+
+type har struct {
+	Log harLog `json:"log"`
+}
+
+type harLog struct {
+	Version string     `json:"version"`
+	Creator harCreator `json:"creator"`
+	Entries []harEntry `json:"entries"`
+}
+
+type harCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type harEntry struct {
+	StartedDateTime string      `json:"startedDateTime"`
+	Time            float64     `json:"time"`
+	Request         harRequest  `json:"request"`
+	Response        harResponse `json:"response"`
+	Cache           struct{}    `json:"cache"`
+	Timings         harTimings  `json:"timings"`
+	ServerIPAddress string      `json:"serverIPAddress,omitempty"`
+	Comment         string      `json:"comment,omitempty"`
+}
+
+type harNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type harRequest struct {
+	Method      string         `json:"method"`
+	URL         string         `json:"url"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []harNameValue `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	QueryString []harNameValue `json:"queryString"`
+	PostData    *harPostData   `json:"postData,omitempty"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harPostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+type harResponse struct {
+	Status      int            `json:"status"`
+	StatusText  string         `json:"statusText"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []harNameValue `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	Content     harContent     `json:"content"`
+	RedirectURL string         `json:"redirectURL"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int64          `json:"bodySize"`
+}
+
+type harContent struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+	Encoding string `json:"encoding,omitempty"`
+}
+
+type harTimings struct {
+	Blocked float64 `json:"blocked"`
+	DNS     float64 `json:"dns"`
+	Connect float64 `json:"connect"`
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+	SSL     float64 `json:"ssl"`
+}
+
+// total returns the sum of the known phases, which is the time of the entry.
+// The SSL time is already part of the connect time.
+func (t harTimings) total() float64 {
+	total := 0.0
+	for _, phase := range []float64{t.Blocked, t.DNS, t.Connect, t.Send, t.Wait, t.Receive} {
+		if phase > 0 {
+			total += phase
+		}
+	}
+	return total
+}
+
+// withoutFragment removes the #fragment of a URL, HAR URLs and the URLs of
+// intercepted requests have none
+func withoutFragment(url string) string {
+	if i := strings.IndexByte(url, '#'); i >= 0 {
+		return url[:i]
+	}
+	return url
+}
+
+// harHeaders converts headers to a list sorted by name
+func harHeaders(headers map[string]string) []harNameValue {
+	list := []harNameValue{}
+	for name, value := range headers {
+		// Repeated headers are joined with newlines by the protocol
+		for _, v := range strings.Split(value, "\n") {
+			list = append(list, harNameValue{Name: name, Value: v})
+		}
+	}
+	sort.SliceStable(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
+
+func header(headers map[string]string, name string) string {
+	for k, v := range headers {
+		if strings.EqualFold(k, name) {
+			return v
+		}
+	}
+	return ""
+}
+
+// harVersion converts a protocol name like "h2" or "http/1.1" to a HAR HTTP
+// version
+func harVersion(protocol string) string {
+	switch protocol {
+	case "h2":
+		return "HTTP/2.0"
+	case "h3":
+		return "HTTP/3.0"
+	case "":
+		return ""
+	}
+	return strings.ToUpper(protocol)
+}
+
+// harTiming splits the duration of a request into phases in milliseconds
+func harTiming(req NetworkRequest) harTimings {
+	total := float64(req.Duration) / float64(time.Millisecond)
+	t := harTimings{Blocked: -1, DNS: -1, Connect: -1, SSL: -1, Wait: total}
+	if req.Response == nil || req.Response.timing == nil {
+		return t
+	}
+	rt := req.Response.timing
+	for _, start := range []float64{rt.DNSStart, rt.ConnectStart, rt.SendStart} {
+		if start >= 0 {
+			t.Blocked = start
+			break
+		}
+	}
+	if rt.DNSStart >= 0 {
+		t.DNS = rt.DNSEnd - rt.DNSStart
+	}
+	if rt.ConnectStart >= 0 {
+		t.Connect = rt.ConnectEnd - rt.ConnectStart
+	}
+	if rt.SSLStart >= 0 {
+		t.SSL = rt.SSLEnd - rt.SSLStart
+	}
+	t.Send = rt.SendEnd - rt.SendStart
+	t.Wait = rt.ReceiveHeadersEnd - rt.SendEnd
+	t.Receive = total - rt.ReceiveHeadersEnd
+	if t.Receive < 0 {
+		t.Receive = 0
+	}
+	return t
+}
+
+// harEntryOf converts a request to a HAR entry, body is the response body if
+// it should be included
+func harEntryOf(req NetworkRequest, body []byte) harEntry {
+	e := harEntry{
+		StartedDateTime: req.Started.UTC().Format(time.RFC3339Nano),
+		Request: harRequest{
+			Method:      req.Method,
+			URL:         withoutFragment(req.URL),
+			Cookies:     []harNameValue{},
+			Headers:     harHeaders(req.Headers),
+			QueryString: []harNameValue{},
+			HeadersSize: -1,
+			BodySize:    len(req.PostData),
+		},
+		Response: harResponse{
+			Cookies:     []harNameValue{},
+			Headers:     []harNameValue{},
+			HeadersSize: -1,
+			BodySize:    -1,
+		},
+		Timings: harTiming(req),
+		Comment: req.Error,
+	}
+	e.Time = e.Timings.total()
+	if u, err := url.Parse(req.URL); err == nil {
+		for name, values := range u.Query() {
+			for _, v := range values {
+				e.Request.QueryString = append(e.Request.QueryString, harNameValue{Name: name, Value: v})
+			}
+		}
+		sort.SliceStable(e.Request.QueryString, func(i, j int) bool {
+			return e.Request.QueryString[i].Name < e.Request.QueryString[j].Name
+		})
+	}
+	if req.PostData != "" {
+		e.Request.PostData = &harPostData{MimeType: header(req.Headers, "Content-Type"), Text: req.PostData}
+	}
+	if res := req.Response; res != nil {
+		e.Request.HTTPVersion = harVersion(res.Protocol)
+		e.Response.Status = res.Status
+		e.Response.StatusText = res.StatusText
+		e.Response.HTTPVersion = harVersion(res.Protocol)
+		e.Response.Headers = harHeaders(res.Headers)
+		e.Response.RedirectURL = res.RedirectURL
+		e.Response.Content.MimeType = res.MimeType
+		e.Response.BodySize = req.EncodedSize
+		e.ServerIPAddress = strings.Trim(res.RemoteIPAddress, "[]")
+		if res.FromCache {
+			e.Response.BodySize = 0
+		}
+	}
+	if body != nil {
+		e.Response.Content.Size = len(body)
+		if utf8.Valid(body) {
+			e.Response.Content.Text = string(body)
+		} else {
+			e.Response.Content.Text = base64.StdEncoding.EncodeToString(body)
+			e.Response.Content.Encoding = "base64"
+		}
+	}
+	return e
+}
+
+// WriteHAR writes the finished requests to w as a HAR 1.2 file. If bodies is
+// true the response bodies are included, as far as they are still available.
+func (r *NetworkRecorder) WriteHAR(w io.Writer, bodies bool) error {
+	l := harLog{
+		Version: "1.2",
+		Creator: harCreator{Name: "lorca", Version: "0.1"},
+		Entries: []harEntry{},
+	}
+	for _, req := range r.Requests() {
+		if !req.Finished {
+			continue
+		}
+		var body []byte
+		if bodies && req.Error == "" && req.Response != nil && req.Response.RedirectURL == "" {
+			body, _ = r.Body(req.ID)
+		}
+		l.Entries = append(l.Entries, harEntryOf(req, body))
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(har{Log: l})
+}
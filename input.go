@@ -0,0 +1,460 @@
+package lorca
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Modifier key bits of Input.dispatchKeyEvent and Input.dispatchMouseEvent
+const (
+	modifierAlt     = 1
+	modifierControl = 2
+	modifierMeta    = 4
+	modifierShift   = 8
+)
+
+// keyDefinition describes a key of a US keyboard
+type keyDefinition struct {
+	key      string // KeyboardEvent.key
+	code     string // KeyboardEvent.code
+	keyCode  int    // KeyboardEvent.keyCode, the Windows virtual key code
+	text     string // the text the key inserts, if any
+	location int    // KeyboardEvent.location
+	modifier int    // the modifier bit, if it is a modifier key
+	shift    bool   // whether the key value needs Shift, like "A" or "!"
+}
+
+// keyDefinitions maps both key codes ("KeyA", "Enter") and key values ("a",
+// "A", "Enter") to the key definitions
+var keyDefinitions = map[string]keyDefinition{}
+
+func init() {
+	add := func(d keyDefinition, names ...string) {
+		for _, name := range names {
+			if _, ok := keyDefinitions[name]; !ok {
+				keyDefinitions[name] = d
+			}
+		}
+	}
+	for r := 'A'; r <= 'Z'; r++ {
+		code := "Key" + string(r)
+		lower := strings.ToLower(string(r))
+		add(keyDefinition{key: lower, code: code, keyCode: int(r), text: lower}, code, lower)
+		add(keyDefinition{key: string(r), code: code, keyCode: int(r), text: string(r), shift: true}, string(r))
+	}
+	shifted := ")!@#$%^&*("
+	for i := 0; i <= 9; i++ {
+		code, digit, symbol := fmt.Sprintf("Digit%d", i), fmt.Sprint(i), string(shifted[i])
+		add(keyDefinition{key: digit, code: code, keyCode: '0' + i, text: digit}, code, digit)
+		add(keyDefinition{key: symbol, code: code, keyCode: '0' + i, text: symbol, shift: true}, symbol)
+	}
+	for _, p := range []struct {
+		code          string
+		keyCode       int
+		key, shiftKey string
+	}{
+		{"Semicolon", 186, ";", ":"},
+		{"Equal", 187, "=", "+"},
+		{"Comma", 188, ",", "<"},
+		{"Minus", 189, "-", "_"},
+		{"Period", 190, ".", ">"},
+		{"Slash", 191, "/", "?"},
+		{"Backquote", 192, "`", "~"},
+		{"BracketLeft", 219, "[", "{"},
+		{"Backslash", 220, "\\", "|"},
+		{"BracketRight", 221, "]", "}"},
+		{"Quote", 222, "'", "\""},
+	} {
+		add(keyDefinition{key: p.key, code: p.code, keyCode: p.keyCode, text: p.key}, p.code, p.key)
+		add(keyDefinition{key: p.shiftKey, code: p.code, keyCode: p.keyCode, text: p.shiftKey, shift: true}, p.shiftKey)
+	}
+	for i := 1; i <= 12; i++ {
+		name := fmt.Sprintf("F%d", i)
+		add(keyDefinition{key: name, code: name, keyCode: 111 + i}, name)
+	}
+	for _, d := range []keyDefinition{
+		{key: "Backspace", code: "Backspace", keyCode: 8},
+		{key: "Tab", code: "Tab", keyCode: 9},
+		{key: "Enter", code: "Enter", keyCode: 13, text: "\r"},
+		{key: "Escape", code: "Escape", keyCode: 27},
+		{key: " ", code: "Space", keyCode: 32, text: " "},
+		{key: "PageUp", code: "PageUp", keyCode: 33},
+		{key: "PageDown", code: "PageDown", keyCode: 34},
+		{key: "End", code: "End", keyCode: 35},
+		{key: "Home", code: "Home", keyCode: 36},
+		{key: "ArrowLeft", code: "ArrowLeft", keyCode: 37},
+		{key: "ArrowUp", code: "ArrowUp", keyCode: 38},
+		{key: "ArrowRight", code: "ArrowRight", keyCode: 39},
+		{key: "ArrowDown", code: "ArrowDown", keyCode: 40},
+		{key: "Insert", code: "Insert", keyCode: 45},
+		{key: "Delete", code: "Delete", keyCode: 46},
+	} {
+		add(d, d.code, d.key)
+	}
+	add(keyDefinition{key: "Enter", code: "Enter", keyCode: 13, text: "\r"}, "\r", "\n")
+	for _, d := range []struct {
+		keyDefinition
+		aliases []string
+	}{
+		{keyDefinition{key: "Shift", code: "ShiftLeft", keyCode: 16, location: 1, modifier: modifierShift}, []string{"Shift", "ShiftLeft"}},
+		{keyDefinition{key: "Control", code: "ControlLeft", keyCode: 17, location: 1, modifier: modifierControl}, []string{"Control", "Ctrl", "ControlLeft"}},
+		{keyDefinition{key: "Alt", code: "AltLeft", keyCode: 18, location: 1, modifier: modifierAlt}, []string{"Alt", "Option", "AltLeft"}},
+		{keyDefinition{key: "Meta", code: "MetaLeft", keyCode: 91, location: 1, modifier: modifierMeta}, []string{"Meta", "Cmd", "Command", "MetaLeft"}},
+	} {
+		add(d.keyDefinition, d.aliases...)
+	}
+}
+
+// shiftModifier returns the Shift bit for key values that are typed with
+// Shift, so that the page sees it in the key events
+func (d keyDefinition) shiftModifier() int {
+	if d.shift {
+		return modifierShift
+	}
+	return 0
+}
+
+// Keyboard sends key events to the page as if they came from a real keyboard.
+// Pressed modifier keys apply to mouse events too.
+type Keyboard struct {
+	sync.Mutex
+	c         *Chrome
+	modifiers int
+	pressed   map[string]bool // codes of the keys that are down
+}
+
+// Keyboard returns the keyboard of the page
+func (c *Chrome) Keyboard() *Keyboard {
+	return c.keyboard
+}
+
+func (k *Keyboard) currentModifiers() int {
+	k.Lock()
+	defer k.Unlock()
+	return k.modifiers
+}
+
+func keyDefinitionOf(key string) (keyDefinition, error) {
+	d, ok := keyDefinitions[key]
+	if !ok {
+		return d, fmt.Errorf("unknown key %q", key)
+	}
+	return d, nil
+}
+
+// Down presses a key and keeps it down, e.g. "Shift", "KeyA", "a" or "Enter".
+// Key values typed with Shift, like "A" or "!", are sent with the Shift
+// modifier.
+func (k *Keyboard) Down(key string) error {
+	d, err := keyDefinitionOf(key)
+	if err != nil {
+		return err
+	}
+	k.Lock()
+	autoRepeat := k.pressed[d.code]
+	k.pressed[d.code] = true
+	k.modifiers |= d.modifier
+	modifiers := k.modifiers | d.shiftModifier()
+	k.Unlock()
+
+	params := h{
+		"type":                  "rawKeyDown",
+		"modifiers":             modifiers,
+		"key":                   d.key,
+		"code":                  d.code,
+		"windowsVirtualKeyCode": d.keyCode,
+		"location":              d.location,
+		"autoRepeat":            autoRepeat,
+	}
+	// Keys pressed together with Control, Alt or Meta are shortcuts, they
+	// don't insert any text
+	if d.text != "" && modifiers&^modifierShift == 0 {
+		params["type"] = "keyDown"
+		params["text"] = d.text
+		params["unmodifiedText"] = d.text
+	}
+	_, err = k.c.Send("Input.dispatchKeyEvent", params)
+	return err
+}
+
+// Up releases a key pressed with Down
+func (k *Keyboard) Up(key string) error {
+	d, err := keyDefinitionOf(key)
+	if err != nil {
+		return err
+	}
+	k.Lock()
+	delete(k.pressed, d.code)
+	k.modifiers &^= d.modifier
+	modifiers := k.modifiers | d.shiftModifier()
+	k.Unlock()
+
+	_, err = k.c.Send("Input.dispatchKeyEvent", h{
+		"type":                  "keyUp",
+		"modifiers":             modifiers,
+		"key":                   d.key,
+		"code":                  d.code,
+		"windowsVirtualKeyCode": d.keyCode,
+		"location":              d.location,
+	})
+	return err
+}
+
+// parseKeys splits a key combination like "Ctrl+Shift+KeyQ" into key names.
+// "+" alone or at the end means the plus key.
+func parseKeys(keys string) ([]string, error) {
+	if keys == "" {
+		return nil, errors.New("no keys")
+	}
+	names := []string{}
+	for keys != "" {
+		i := strings.Index(keys[1:], "+") + 1
+		if i == 0 {
+			names = append(names, keys)
+			break
+		}
+		names = append(names, keys[:i])
+		keys = keys[i+1:]
+		if keys == "" {
+			return nil, fmt.Errorf("missing key after %q", names[len(names)-1])
+		}
+	}
+	for _, name := range names {
+		if _, err := keyDefinitionOf(name); err != nil {
+			return nil, err
+		}
+	}
+	return names, nil
+}
+
+// Press presses and releases a key or a key combination like "Enter",
+// "Ctrl+KeyN" or "Control+Shift+KeyQ". Keys of a combination are pressed in
+// order and released in reverse order.
+func (k *Keyboard) Press(keys string) error {
+	names, err := parseKeys(keys)
+	if err != nil {
+		return err
+	}
+	for i, name := range names {
+		if err := k.Down(name); err != nil {
+			for j := i - 1; j >= 0; j-- {
+				k.Up(names[j])
+			}
+			return err
+		}
+	}
+	for i := len(names) - 1; i >= 0; i-- {
+		if err := k.Up(names[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Type types the text character by character. Characters that are not on a
+// US keyboard are inserted without key events.
+func (k *Keyboard) Type(text string) error {
+	for _, r := range text {
+		s := string(r)
+		if _, ok := keyDefinitions[s]; ok {
+			if err := k.Press(s); err != nil {
+				return err
+			}
+		} else if err := k.InsertText(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// InsertText inserts the text into the focused element like an input method
+// does, without any key events
+func (k *Keyboard) InsertText(text string) error {
+	_, err := k.c.Send("Input.insertText", h{"text": text})
+	return err
+}
+
+// MouseButton is a mouse button
+type MouseButton string
+
+// Mouse buttons
+const (
+	MouseLeft   MouseButton = "left"
+	MouseMiddle MouseButton = "middle"
+	MouseRight  MouseButton = "right"
+)
+
+// bit returns the bit of a mouse button in MouseEvent.buttons
+func (b MouseButton) bit() int {
+	switch b {
+	case MouseLeft:
+		return 1
+	case MouseRight:
+		return 2
+	case MouseMiddle:
+		return 4
+	}
+	return 0
+}
+
+// Mouse sends mouse events to the page as if they came from a real mouse.
+// Coordinates are in CSS pixels relative to the viewport.
+type Mouse struct {
+	sync.Mutex
+	c       *Chrome
+	x, y    float64
+	buttons int
+}
+
+// Mouse returns the mouse of the page
+func (c *Chrome) Mouse() *Mouse {
+	return c.mouse
+}
+
+func (m *Mouse) dispatch(event string, button MouseButton, clickCount int, extra h) error {
+	m.Lock()
+	params := h{
+		"type":       event,
+		"x":          m.x,
+		"y":          m.y,
+		"button":     "none",
+		"buttons":    m.buttons,
+		"clickCount": clickCount,
+		"modifiers":  m.c.keyboard.currentModifiers(),
+	}
+	m.Unlock()
+	if button != "" {
+		params["button"] = button
+	}
+	for k, v := range extra {
+		params[k] = v
+	}
+	_, err := m.c.Send("Input.dispatchMouseEvent", params)
+	return err
+}
+
+// Move moves the mouse to x, y in the given number of steps, at least one
+func (m *Mouse) Move(x, y float64, steps int) error {
+	if steps < 1 {
+		steps = 1
+	}
+	m.Lock()
+	fromX, fromY := m.x, m.y
+	m.Unlock()
+	for i := 1; i <= steps; i++ {
+		m.Lock()
+		m.x = fromX + (x-fromX)*float64(i)/float64(steps)
+		m.y = fromY + (y-fromY)*float64(i)/float64(steps)
+		m.Unlock()
+		if err := m.dispatch("mouseMoved", "", 0, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Down presses a mouse button at the current position. clickCount is 1 for a
+// single click, 2 for a double click and so on.
+func (m *Mouse) Down(button MouseButton, clickCount int) error {
+	m.Lock()
+	m.buttons |= button.bit()
+	m.Unlock()
+	return m.dispatch("mousePressed", button, clickCount, nil)
+}
+
+// Up releases a mouse button at the current position
+func (m *Mouse) Up(button MouseButton, clickCount int) error {
+	m.Lock()
+	m.buttons &^= button.bit()
+	m.Unlock()
+	return m.dispatch("mouseReleased", button, clickCount, nil)
+}
+
+// Click moves the mouse to x, y and clicks the button
+func (m *Mouse) Click(x, y float64, button MouseButton) error {
+	if err := m.Move(x, y, 1); err != nil {
+		return err
+	}
+	if err := m.Down(button, 1); err != nil {
+		return err
+	}
+	return m.Up(button, 1)
+}
+
+// Wheel scrolls with the mouse wheel at the current position by dx, dy
+// pixels
+func (m *Mouse) Wheel(dx, dy float64) error {
+	return m.dispatch("mouseWheel", "", 0, h{"deltaX": dx, "deltaY": dy})
+}
+
+// Drag presses the left button at one point, moves the mouse to another in
+// small steps and releases the button there
+func (m *Mouse) Drag(fromX, fromY, toX, toY float64) error {
+	if err := m.Move(fromX, fromY, 1); err != nil {
+		return err
+	}
+	if err := m.Down(MouseLeft, 1); err != nil {
+		return err
+	}
+	if err := m.Move(toX, toY, 10); err != nil {
+		m.Up(MouseLeft, 1)
+		return err
+	}
+	return m.Up(MouseLeft, 1)
+}
+
+// TouchPoint is a finger touching the screen at X, Y in CSS pixels relative
+// to the viewport
+type TouchPoint struct {
+	X  float64 `json:"x"`
+	Y  float64 `json:"y"`
+	ID int     `json:"id"`
+}
+
+// Touch sends touch events to the page as if they came from a touch screen
+type Touch struct {
+	c *Chrome
+}
+
+// Touch returns the touch screen of the page
+func (c *Chrome) Touch() *Touch {
+	return c.touch
+}
+
+func (t *Touch) dispatch(event string, points []TouchPoint) error {
+	if points == nil {
+		points = []TouchPoint{}
+	}
+	_, err := t.c.Send("Input.dispatchTouchEvent", h{
+		"type":        event,
+		"touchPoints": points,
+		"modifiers":   t.c.keyboard.currentModifiers(),
+	})
+	return err
+}
+
+// Start puts fingers on the screen
+func (t *Touch) Start(points ...TouchPoint) error {
+	return t.dispatch("touchStart", points)
+}
+
+// Move moves the fingers on the screen, points are all the fingers that are
+// still touching it
+func (t *Touch) Move(points ...TouchPoint) error {
+	return t.dispatch("touchMove", points)
+}
+
+// End lifts all the fingers
+func (t *Touch) End() error {
+	return t.dispatch("touchEnd", nil)
+}
+
+// Tap taps the screen at x, y with one finger
+func (t *Touch) Tap(x, y float64) error {
+	if err := t.Start(TouchPoint{X: x, Y: y}); err != nil {
+		return err
+	}
+	return t.End()
+}
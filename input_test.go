@@ -0,0 +1,116 @@
+package lorca
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// answerTransport answers every command with an empty result and keeps the
+// commands sent
+type answerTransport struct {
+	sync.Mutex
+	sent    []msg
+	answers chan []byte
+}
+
+func (t *answerTransport) Send(b []byte) error {
+	m := msg{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+	t.Lock()
+	t.sent = append(t.sent, m)
+	t.Unlock()
+	t.answers <- []byte(fmt.Sprintf(`{"id":%d,"result":{}}`, m.ID))
+	return nil
+}
+
+func (t *answerTransport) Receive() ([]byte, error) {
+	b, ok := <-t.answers
+	if !ok {
+		return nil, errors.New("closed")
+	}
+	return b, nil
+}
+
+func (t *answerTransport) Close() error { return nil }
+
+func TestParseKeys(t *testing.T) {
+	for _, test := range []struct {
+		Keys  string
+		Names []string
+		Error string
+	}{
+		{Keys: "Enter", Names: []string{"Enter"}},
+		{Keys: "a", Names: []string{"a"}},
+		{Keys: "Ctrl+Shift+KeyQ", Names: []string{"Ctrl", "Shift", "KeyQ"}},
+		{Keys: "+", Names: []string{"+"}},
+		{Keys: "Control++", Names: []string{"Control", "+"}},
+		{Keys: "", Error: "no keys"},
+		{Keys: "Ctrl+", Error: `missing key after "Ctrl"`},
+		{Keys: "Ctrl+Foo", Error: `unknown key "Foo"`},
+	} {
+		names, err := parseKeys(test.Keys)
+		if err != nil {
+			if err.Error() != test.Error {
+				t.Fatal(test.Keys, err, test.Error)
+			}
+		} else if !reflect.DeepEqual(names, test.Names) {
+			t.Fatal(test.Keys, names, test.Names)
+		}
+	}
+}
+
+func TestKeyDefinitions(t *testing.T) {
+	for _, test := range []struct {
+		Key        string
+		Definition keyDefinition
+	}{
+		{Key: "a", Definition: keyDefinition{key: "a", code: "KeyA", keyCode: 65, text: "a"}},
+		{Key: "KeyA", Definition: keyDefinition{key: "a", code: "KeyA", keyCode: 65, text: "a"}},
+		{Key: "A", Definition: keyDefinition{key: "A", code: "KeyA", keyCode: 65, text: "A", shift: true}},
+		{Key: "7", Definition: keyDefinition{key: "7", code: "Digit7", keyCode: 55, text: "7"}},
+		{Key: "&", Definition: keyDefinition{key: "&", code: "Digit7", keyCode: 55, text: "&", shift: true}},
+		{Key: "?", Definition: keyDefinition{key: "?", code: "Slash", keyCode: 191, text: "?", shift: true}},
+		{Key: "\n", Definition: keyDefinition{key: "Enter", code: "Enter", keyCode: 13, text: "\r"}},
+		{Key: "F4", Definition: keyDefinition{key: "F4", code: "F4", keyCode: 115}},
+		{Key: "Ctrl", Definition: keyDefinition{key: "Control", code: "ControlLeft", keyCode: 17, location: 1, modifier: modifierControl}},
+	} {
+		if d := keyDefinitions[test.Key]; d != test.Definition {
+			t.Fatal(test.Key, d, test.Definition)
+		}
+	}
+}
+
+func TestKeyboardShift(t *testing.T) {
+	tr := &answerTransport{answers: make(chan []byte, 16)}
+	c := newChrome()
+	c.conn = tr
+	go c.readLoop()
+	defer close(tr.answers)
+
+	if err := c.Keyboard().Type("aB!"); err != nil {
+		t.Fatal(err)
+	}
+	events := []string{}
+	for _, m := range tr.sent {
+		e := struct {
+			Type      string `json:"type"`
+			Key       string `json:"key"`
+			Modifiers int    `json:"modifiers"`
+		}{}
+		json.Unmarshal(m.Params, &e)
+		events = append(events, fmt.Sprintf("%s %s %d", e.Type, e.Key, e.Modifiers))
+	}
+	if !reflect.DeepEqual(events, []string{
+		"keyDown a 0", "keyUp a 0",
+		"keyDown B 8", "keyUp B 8",
+		"keyDown ! 8", "keyUp ! 8",
+	}) {
+		t.Fatal(events)
+	}
+}
@@ -0,0 +1,67 @@
+package lorca
+
+import "strings"
+
+// JSError is an exception thrown by JavaScript code, e.g. by an expression
+// passed to Eval. Use errors.As to get it from the returned error.
+type JSError struct {
+	// Name is the error class, e.g. "TypeError". It is empty if the thrown
+	// value is not an Error object.
+	Name string
+	// Message is the error message. If the thrown value is not an Error
+	// object, Message is the value itself: strings as is, other values
+	// formatted like in the console.
+	Message string
+	// URL, Line and Column tell where the exception has been thrown. Lines
+	// and columns are 1-based.
+	URL    string
+	Line   int
+	Column int
+	// Stack is the call stack at the moment of throwing, if available
+	Stack []StackFrame
+}
+
+func (e *JSError) Error() string {
+	if e.Name == "" {
+		return e.Message
+	}
+	if e.Message == "" {
+		return e.Name
+	}
+	return e.Name + ": " + e.Message
+}
+
+// jsError converts exception details to a *JSError
+func (d *exceptionDetails) jsError() *JSError {
+	e := &JSError{
+		URL:    d.URL,
+		Line:   d.LineNumber + 1,
+		Column: d.ColumnNumber + 1,
+		Stack:  d.StackTrace.frames(),
+	}
+	ex := d.Exception
+	if ex == nil {
+		e.Message = d.Text
+		return e
+	}
+	if ex.Type == "object" && ex.Subtype == "error" {
+		// Description is "<name>: <message>" followed by the stack lines
+		head := strings.SplitN(ex.Description, "\n    at ", 2)[0]
+		if i := strings.Index(head, ": "); i >= 0 {
+			e.Name, e.Message = head[:i], head[i+2:]
+		} else {
+			e.Name = head
+		}
+		if e.Name == "" {
+			e.Name = ex.ClassName
+		}
+	} else if ex.Type != "string" && ex.Value != nil {
+		e.Message = string(ex.Value)
+	} else {
+		e.Message = ex.format()
+	}
+	if e.URL == "" && len(e.Stack) > 0 {
+		e.URL, e.Line, e.Column = e.Stack[0].URL, e.Stack[0].Line, e.Stack[0].Column
+	}
+	return e
+}
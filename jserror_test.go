@@ -0,0 +1,59 @@
+package lorca
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestJSError(t *testing.T) {
+	for _, test := range []struct {
+		Result  string
+		Error   string
+		Name    string
+		Message string
+		Line    int
+		Stack   int
+	}{
+		{
+			Result: `{"result": {"type": "string", "value": "bar"}, "exceptionDetails": {
+				"text": "Uncaught", "lineNumber": 0, "columnNumber": 0,
+				"exception": {"type": "string", "value": "bar"}}}`,
+			Error: `bar`, Message: "bar", Line: 1,
+		},
+		{
+			Result: `{"result": {"type": "object"}, "exceptionDetails": {
+				"text": "Uncaught", "lineNumber": 2, "columnNumber": 10,
+				"exception": {"type": "object", "value": {"code":42}}}}`,
+			Error: `{"code":42}`, Message: `{"code":42}`, Line: 3,
+		},
+		{
+			Result: `{"result": {"type": "object", "subtype": "error"}, "exceptionDetails": {
+				"text": "Uncaught", "lineNumber": 0, "columnNumber": 2,
+				"exception": {"type": "object", "subtype": "error", "className": "SyntaxError",
+					"description": "SyntaxError: Unexpected end of input"}}}`,
+			Error: `SyntaxError: Unexpected end of input`, Name: "SyntaxError", Message: "Unexpected end of input", Line: 1,
+		},
+		{
+			Result: `{"result": {"type": "object", "subtype": "error"}, "exceptionDetails": {
+				"text": "Uncaught", "lineNumber": 1, "columnNumber": 8, "url": "http://app/main.js",
+				"stackTrace": {"callFrames": [
+					{"functionName": "f", "url": "http://app/main.js", "lineNumber": 1, "columnNumber": 8},
+					{"functionName": "", "url": "http://app/main.js", "lineNumber": 4, "columnNumber": 0}
+				]},
+				"exception": {"type": "object", "subtype": "error", "className": "TypeError",
+					"description": "TypeError: x is not a function\n    at f (http://app/main.js:2:9)\n    at http://app/main.js:5:1"}}}`,
+			Error: `TypeError: x is not a function`, Name: "TypeError", Message: "x is not a function", Line: 2, Stack: 2,
+		},
+	} {
+		_, err := evalValue(json.RawMessage(test.Result))
+		jsErr := &JSError{}
+		if !errors.As(err, &jsErr) {
+			t.Fatal(err)
+		}
+		if err.Error() != test.Error || jsErr.Name != test.Name || jsErr.Message != test.Message ||
+			jsErr.Line != test.Line || len(jsErr.Stack) != test.Stack {
+			t.Fatal(test.Error, jsErr)
+		}
+	}
+}
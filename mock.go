@@ -0,0 +1,205 @@
+package lorca
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// MockRequest is a request intercepted by a mock
+type MockRequest struct {
+	URL          string
+	Method       string
+	Headers      map[string]string
+	Body         []byte
+	ResourceType string
+}
+
+// MockResponse is an answer to an intercepted request
+type MockResponse struct {
+	Status  int
+	Headers http.Header
+	Body    []byte
+}
+
+// MockAnswer answers an intercepted request. It may return a response, an
+// error to fail the request, or neither to let the request go on to the
+// network.
+type MockAnswer func(req *MockRequest) (*MockResponse, error)
+
+// mockFailure makes a request fail with a Network.ErrorReason
+type mockFailure string
+
+func (f mockFailure) Error() string { return "request failed: " + string(f) }
+
+// MockBody answers with a static response
+func MockBody(status int, contentType string, body []byte) MockAnswer {
+	return func(req *MockRequest) (*MockResponse, error) {
+		return &MockResponse{
+			Status:  status,
+			Headers: http.Header{"Content-Type": {contentType}},
+			Body:    body,
+		}, nil
+	}
+}
+
+// MockFail makes requests fail with the given Network.ErrorReason, such as
+// "Failed", "ConnectionRefused", "TimedOut" or "InternetDisconnected"
+func MockFail(reason string) MockAnswer {
+	return func(req *MockRequest) (*MockResponse, error) {
+		return nil, mockFailure(reason)
+	}
+}
+
+// MockHandler answers with a http.Handler
+func MockHandler(handler http.Handler) MockAnswer {
+	return func(req *MockRequest) (*MockResponse, error) {
+		r, err := http.NewRequest(req.Method, req.URL, bytes.NewReader(req.Body))
+		if err != nil {
+			return nil, err
+		}
+		for name, value := range req.Headers {
+			r.Header.Set(name, value)
+		}
+		r.RequestURI = r.URL.RequestURI()
+		r.RemoteAddr = "127.0.0.1:0"
+		w := &responseWriter{header: http.Header{}}
+		handler.ServeHTTP(w, r)
+		w.WriteHeader(http.StatusOK)
+		return &MockResponse{Status: w.status, Headers: w.header, Body: w.body.Bytes()}, nil
+	}
+}
+
+// MockHAR answers with the responses recorded in a HAR file, e.g. one
+// written by NetworkRecorder.WriteHAR. Requests are matched by method and URL,
+// repeated requests get the recorded responses in turn. Requests that are not
+// in the file fail, so that tests never reach the real backend.
+func MockHAR(r io.Reader) (MockAnswer, error) {
+	archive := har{}
+	if err := json.NewDecoder(r).Decode(&archive); err != nil {
+		return nil, err
+	}
+	var mu sync.Mutex
+	entries := map[string][]harEntry{}
+	for _, e := range archive.Log.Entries {
+		if e.Response.Status == 0 {
+			continue
+		}
+		key := e.Request.Method + " " + withoutFragment(e.Request.URL)
+		entries[key] = append(entries[key], e)
+	}
+	return func(req *MockRequest) (*MockResponse, error) {
+		key := req.Method + " " + withoutFragment(req.URL)
+		mu.Lock()
+		list := entries[key]
+		if len(list) == 0 {
+			mu.Unlock()
+			return nil, mockFailure("Failed")
+		}
+		e := list[0]
+		if len(list) > 1 {
+			entries[key] = list[1:]
+		}
+		mu.Unlock()
+		return harResponseOf(e)
+	}, nil
+}
+
+func harResponseOf(e harEntry) (*MockResponse, error) {
+	res := &MockResponse{Status: e.Response.Status, Headers: http.Header{}}
+	for _, h := range e.Response.Headers {
+		// The recorded body is decoded and may have been truncated
+		if strings.EqualFold(h.Name, "Content-Encoding") || strings.EqualFold(h.Name, "Content-Length") {
+			continue
+		}
+		res.Headers.Add(h.Name, h.Value)
+	}
+	if e.Response.RedirectURL != "" && res.Headers.Get("Location") == "" {
+		res.Headers.Set("Location", e.Response.RedirectURL)
+	}
+	if e.Response.Content.Encoding == "base64" {
+		body, err := base64.StdEncoding.DecodeString(e.Response.Content.Text)
+		if err != nil {
+			return nil, err
+		}
+		res.Body = body
+	} else {
+		res.Body = []byte(e.Response.Content.Text)
+	}
+	return res, nil
+}
+
+// globRegexp converts a Fetch.RequestPattern URL pattern, where * matches any
+// string, ? any character and backslash escapes, to a regexp
+func globRegexp(glob string) *regexp.Regexp {
+	s := "^"
+	for i := 0; i < len(glob); i++ {
+		switch ch := glob[i]; {
+		case ch == '*':
+			s += ".*"
+		case ch == '?':
+			s += "."
+		case ch == '\\' && i+1 < len(glob):
+			i++
+			s += regexp.QuoteMeta(glob[i : i+1])
+		default:
+			s += regexp.QuoteMeta(glob[i : i+1])
+		}
+	}
+	return regexp.MustCompile(s + "$")
+}
+
+// Mock answers the requests whose URL matches the glob pattern, where *
+// matches any string and ? any character, e.g. "https://api.test/users/*".
+// Mocks added later take precedence. The returned function removes the mock.
+func (c *Chrome) Mock(pattern string, answer MockAnswer) (func(), error) {
+	re := globRegexp(pattern)
+	return c.addRoute(route{patterns: []string{pattern}, match: re.MatchString, handle: c.mockHandle(answer)})
+}
+
+// MockRegexp answers the requests whose URL matches the regular expression,
+// like Mock. The browser can only intercept requests by glob patterns, so
+// every request matching the globs, or every request of the page if none is
+// given, is paused and passed through Go, even if the regexp rejects it. Globs
+// like "https://api.test/*" keep that cost down.
+func (c *Chrome) MockRegexp(re *regexp.Regexp, answer MockAnswer, globs ...string) (func(), error) {
+	if len(globs) == 0 {
+		globs = []string{"*"}
+	}
+	return c.addRoute(route{patterns: globs, match: re.MatchString, handle: c.mockHandle(answer)})
+}
+
+func (c *Chrome) mockHandle(answer MockAnswer) func(req *pausedRequest) error {
+	return func(req *pausedRequest) error {
+		body, err := c.postData(req)
+		if err != nil {
+			return err
+		}
+		res, err := answer(&MockRequest{
+			URL:          req.Request.URL,
+			Method:       req.Request.Method,
+			Headers:      req.Request.Headers,
+			Body:         body,
+			ResourceType: req.ResourceType,
+		})
+		if f, ok := err.(mockFailure); ok {
+			_, err = c.Send("Fetch.failRequest", h{"requestId": req.RequestID, "errorReason": string(f)})
+			return err
+		} else if err != nil {
+			return err
+		} else if res == nil {
+			_, err = c.Send("Fetch.continueRequest", h{"requestId": req.RequestID})
+			return err
+		}
+		status := res.Status
+		if status == 0 {
+			status = http.StatusOK
+		}
+		return c.fulfill(req, status, res.Headers, res.Body)
+	}
+}
@@ -0,0 +1,83 @@
+package lorca
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestGlobRegexp(t *testing.T) {
+	for _, test := range []struct {
+		Glob  string
+		URL   string
+		Match bool
+	}{
+		{Glob: "*", URL: "https://example.test/", Match: true},
+		{Glob: "https://api.test/*", URL: "https://api.test/users/1", Match: true},
+		{Glob: "https://api.test/*", URL: "https://api.test.evil/", Match: false},
+		{Glob: "*.png", URL: "https://example.test/a.png", Match: true},
+		{Glob: "*.png", URL: "https://example.test/apng", Match: false},
+		{Glob: "https://example.test/?", URL: "https://example.test/a", Match: true},
+		{Glob: "https://example.test/?", URL: "https://example.test/ab", Match: false},
+		{Glob: `https://example.test/\*`, URL: "https://example.test/*", Match: true},
+		{Glob: `https://example.test/\*`, URL: "https://example.test/a", Match: false},
+	} {
+		if m := globRegexp(test.Glob).MatchString(test.URL); m != test.Match {
+			t.Fatal(test.Glob, test.URL, m)
+		}
+	}
+}
+
+func TestMockAnswers(t *testing.T) {
+	req := &MockRequest{URL: "https://api.test/users?id=1", Method: "POST", Headers: map[string]string{"X-Test": "yes"}, Body: []byte("ping")}
+
+	res, err := MockBody(404, "application/json", []byte(`{}`))(req)
+	if err != nil || res.Status != 404 || res.Headers.Get("Content-Type") != "application/json" || string(res.Body) != `{}` {
+		t.Fatal(res, err)
+	}
+
+	if _, err := MockFail("ConnectionRefused")(req); err != mockFailure("ConnectionRefused") {
+		t.Fatal(err)
+	}
+
+	res, err = MockHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		w.Write([]byte(r.Method + " " + r.RequestURI + " " + r.Header.Get("X-Test") + " " + string(body)))
+	}))(req)
+	if err != nil || res.Status != 200 || string(res.Body) != "POST /users?id=1 yes ping" {
+		t.Fatal(res, err)
+	}
+
+	answer, err := MockHAR(strings.NewReader(`{"log": {"version": "1.2", "entries": [
+		{"request": {"method": "POST", "url": "https://api.test/users?id=1"},
+		 "response": {"status": 200, "headers": [{"name": "Content-Type", "value": "text/plain"}, {"name": "Content-Encoding", "value": "gzip"}],
+		  "content": {"mimeType": "text/plain", "text": "first"}}},
+		{"request": {"method": "POST", "url": "https://api.test/users?id=1"},
+		 "response": {"status": 200, "content": {"mimeType": "image/png", "text": "iVBORw==", "encoding": "base64"}}},
+		{"request": {"method": "GET", "url": "https://api.test/old#section"},
+		 "response": {"status": 301, "redirectURL": "https://api.test/new", "content": {}}},
+		{"request": {"method": "GET", "url": "https://api.test/failed"},
+		 "response": {"status": 0, "content": {}}, "comment": "net::ERR_FAILED"}
+	]}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res, err := answer(req); err != nil || string(res.Body) != "first" || res.Headers.Get("Content-Encoding") != "" {
+		t.Fatal(res, err)
+	}
+	for i := 0; i < 2; i++ {
+		// The last recorded response is repeated
+		if res, err := answer(req); err != nil || string(res.Body) != "\x89PNG" {
+			t.Fatal(res, err)
+		}
+	}
+	if res, err := answer(&MockRequest{Method: "GET", URL: "https://api.test/old"}); err != nil || res.Status != 301 || res.Headers.Get("Location") != "https://api.test/new" {
+		t.Fatal(res, err)
+	}
+	for _, url := range []string{"https://api.test/failed", "https://api.test/unknown"} {
+		if _, err := answer(&MockRequest{Method: "GET", URL: url}); err != mockFailure("Failed") {
+			t.Fatal(url, err)
+		}
+	}
+}
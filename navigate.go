@@ -0,0 +1,214 @@
+package lorca
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// WaitCondition tells whether a navigation has finished. It is called with
+// the name of every lifecycle event of the new document, such as "init",
+// "DOMContentLoaded", "load", "firstContentfulPaint", "networkAlmostIdle" and
+// "networkIdle", until it returns true.
+type WaitCondition func(event string) bool
+
+// waitEvent returns a condition that is met by the given lifecycle event
+func waitEvent(name string) WaitCondition {
+	return func(event string) bool { return event == name }
+}
+
+var (
+	// WaitDOMContentLoaded waits until the document has been parsed
+	WaitDOMContentLoaded = waitEvent("DOMContentLoaded")
+	// WaitLoad waits until the document and all of its resources have been
+	// loaded
+	WaitLoad = waitEvent("load")
+	// WaitNetworkIdle waits until there have been no network connections for
+	// at least 500ms
+	WaitNetworkIdle = waitEvent("networkIdle")
+)
+
+// LoadAndWait navigates to url and waits until the new document meets the
+// condition, WaitLoad if it is nil. A timeout of zero means no timeout. It
+// fails if the page can't be loaded, e.g. with net::ERR_NAME_NOT_RESOLVED.
+func (c *Chrome) LoadAndWait(url string, until WaitCondition, timeout time.Duration) error {
+	c.Lock()
+	c.url = url
+	c.Unlock()
+	return c.navigateAndWait(until, timeout, func(ctx context.Context) (string, error) {
+		raw, err := c.SendContext(ctx, "Page.navigate", h{"url": url})
+		if err != nil {
+			return "", err
+		}
+		res := struct {
+			LoaderID  string `json:"loaderId"`
+			ErrorText string `json:"errorText"`
+		}{}
+		if err := json.Unmarshal(raw, &res); err != nil {
+			return "", err
+		} else if res.ErrorText != "" {
+			return "", errors.New(res.ErrorText)
+		} else if res.LoaderID == "" {
+			// Navigation within the same document, like a fragment change
+			return "", errNavigatedWithinDocument
+		}
+		return res.LoaderID, nil
+	})
+}
+
+// ReloadAndWait reloads the current page and waits like LoadAndWait
+func (c *Chrome) ReloadAndWait(until WaitCondition, timeout time.Duration) error {
+	return c.navigateAndWait(until, timeout, func(ctx context.Context) (string, error) {
+		_, err := c.SendContext(ctx, "Page.reload", nil)
+		return "", err
+	})
+}
+
+// BackAndWait navigates to the previous page in browser history and waits
+// like LoadAndWait
+func (c *Chrome) BackAndWait(until WaitCondition, timeout time.Duration) error {
+	return c.goDeltaAndWait(-1, until, timeout)
+}
+
+// ForwardAndWait navigates to the next page in browser history and waits like
+// LoadAndWait
+func (c *Chrome) ForwardAndWait(until WaitCondition, timeout time.Duration) error {
+	return c.goDeltaAndWait(1, until, timeout)
+}
+
+func (c *Chrome) goDeltaAndWait(delta int, until WaitCondition, timeout time.Duration) error {
+	entry, err := c.historyEntry(delta)
+	if err != nil {
+		return err
+	}
+	return c.navigateAndWait(until, timeout, func(ctx context.Context) (string, error) {
+		_, err := c.SendContext(ctx, "Page.navigateToHistoryEntry", h{"entryId": entry})
+		return "", err
+	})
+}
+
+var errNavigatedWithinDocument = errors.New("navigated within document")
+
+// navigateAndWait starts a navigation of the main frame and waits until the
+// condition is met by a lifecycle event of the new document. navigate returns
+// the loader ID of the new document if it is known, otherwise it is taken from
+// the next Page.frameNavigated.
+func (c *Chrome) navigateAndWait(until WaitCondition, timeout time.Duration, navigate func(ctx context.Context) (string, error)) error {
+	if until == nil {
+		until = WaitLoad
+	}
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	c.Lock()
+	target := c.target
+	c.Unlock()
+	var mu sync.Mutex
+	loader := ""
+	events := map[string][]string{} // lifecycle events by loader ID
+	done := make(chan error, 1)
+	finish := func(err error) {
+		select {
+		case done <- err:
+		default:
+		}
+	}
+	// check must be called with mu locked
+	check := func() {
+		for _, event := range events[loader] {
+			if until(event) {
+				finish(nil)
+				return
+			}
+		}
+	}
+
+	offLifecycle := c.On("Page.lifecycleEvent", func(params json.RawMessage) {
+		res := struct {
+			FrameID  string `json:"frameId"`
+			LoaderID string `json:"loaderId"`
+			Name     string `json:"name"`
+		}{}
+		if json.Unmarshal(params, &res) != nil || res.FrameID != target {
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if res.Name == "init" {
+			events[res.LoaderID] = nil
+		}
+		events[res.LoaderID] = append(events[res.LoaderID], res.Name)
+		if loader == res.LoaderID {
+			check()
+		}
+	})
+	defer offLifecycle()
+	offNavigated := c.On("Page.frameNavigated", func(params json.RawMessage) {
+		res := struct {
+			Frame struct {
+				ID             string `json:"id"`
+				ParentID       string `json:"parentId"`
+				LoaderID       string `json:"loaderId"`
+				UnreachableURL string `json:"unreachableUrl"`
+			} `json:"frame"`
+		}{}
+		if json.Unmarshal(params, &res) != nil || res.Frame.ID != target || res.Frame.ParentID != "" {
+			return
+		}
+		if res.Frame.UnreachableURL != "" {
+			finish(fmt.Errorf("failed to load %s", res.Frame.UnreachableURL))
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if loader == "" {
+			loader = res.Frame.LoaderID
+			check()
+		}
+	})
+	defer offNavigated()
+	offWithinDocument := c.On("Page.navigatedWithinDocument", func(params json.RawMessage) {
+		res := struct {
+			FrameID string `json:"frameId"`
+		}{}
+		if json.Unmarshal(params, &res) != nil || res.FrameID != target {
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if loader == "" {
+			finish(nil)
+		}
+	})
+	defer offWithinDocument()
+
+	id, err := navigate(ctx)
+	if err == errNavigatedWithinDocument {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if id != "" {
+		mu.Lock()
+		if loader == "" {
+			loader = id
+			check()
+		}
+		mu.Unlock()
+	}
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-c.done:
+		return ErrBrowserClosed
+	}
+}
@@ -0,0 +1,39 @@
+package lorca
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestReplayNavigate(t *testing.T) {
+	c := replay(t, "testdata/navigate.jsonl")
+	defer c.Kill()
+
+	if _, err := c.ServeHandler("http://example.test", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			time.Sleep(time.Second)
+		}
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<p id="top">example</p>`))
+	})); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.LoadAndWait("http://example.test/", WaitLoad, 5*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.LoadAndWait("http://example.test/#top", nil, 5*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	domReady := func(event string) bool { return event == "DOMContentLoaded" }
+	if err := c.ReloadAndWait(domReady, 5*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.LoadAndWait("http://nonexistent.invalid/", WaitLoad, 5*time.Second); err == nil || err.Error() != "net::ERR_NAME_NOT_RESOLVED" {
+		t.Fatal(err)
+	}
+	if err := c.LoadAndWait("http://example.test/slow", WaitLoad, 50*time.Millisecond); err != context.DeadlineExceeded {
+		t.Fatal(err)
+	}
+}
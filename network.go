@@ -0,0 +1,258 @@
+package lorca
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"math"
+	"sync"
+	"time"
+)
+
+// NetworkRequest is a request made by the page, together with its response
+// once it has been received
+type NetworkRequest struct {
+	// ID is the Network.RequestId, redirects of a request share it
+	ID           string
+	URL          string
+	Method       string
+	Headers      map[string]string
+	PostData     string
+	ResourceType string
+	Started      time.Time
+	// Duration is the time from sending the request until the response has
+	// been loaded or the request has failed
+	Duration time.Duration
+	Response *NetworkResponse
+	// Finished is set when the response has been loaded, or the request has
+	// failed with Error
+	Finished bool
+	Error    string
+	// EncodedSize is the number of bytes received over the network
+	EncodedSize int64
+
+	timestamp float64 // monotonic time in seconds
+}
+
+// NetworkResponse is a response to a NetworkRequest
+type NetworkResponse struct {
+	Status          int
+	StatusText      string
+	Headers         map[string]string
+	MimeType        string
+	Protocol        string
+	RemoteIPAddress string
+	FromCache       bool
+	// RedirectURL is the location of a redirect response
+	RedirectURL string
+
+	timing *resourceTiming
+}
+
+// resourceTiming is Network.ResourceTiming, offsets are in milliseconds
+// since RequestTime, -1 if not applicable
+type resourceTiming struct {
+	RequestTime       float64 `json:"requestTime"`
+	DNSStart          float64 `json:"dnsStart"`
+	DNSEnd            float64 `json:"dnsEnd"`
+	ConnectStart      float64 `json:"connectStart"`
+	ConnectEnd        float64 `json:"connectEnd"`
+	SSLStart          float64 `json:"sslStart"`
+	SSLEnd            float64 `json:"sslEnd"`
+	SendStart         float64 `json:"sendStart"`
+	SendEnd           float64 `json:"sendEnd"`
+	ReceiveHeadersEnd float64 `json:"receiveHeadersEnd"`
+}
+
+type networkResponse struct {
+	URL               string            `json:"url"`
+	Status            int               `json:"status"`
+	StatusText        string            `json:"statusText"`
+	Headers           map[string]string `json:"headers"`
+	MimeType          string            `json:"mimeType"`
+	Protocol          string            `json:"protocol"`
+	RemoteIPAddress   string            `json:"remoteIPAddress"`
+	FromDiskCache     bool              `json:"fromDiskCache"`
+	FromServiceWorker bool              `json:"fromServiceWorker"`
+	EncodedDataLength float64           `json:"encodedDataLength"`
+	Timing            *resourceTiming   `json:"timing"`
+}
+
+func (r *networkResponse) response() *NetworkResponse {
+	return &NetworkResponse{
+		Status:          r.Status,
+		StatusText:      r.StatusText,
+		Headers:         r.Headers,
+		MimeType:        r.MimeType,
+		Protocol:        r.Protocol,
+		RemoteIPAddress: r.RemoteIPAddress,
+		FromCache:       r.FromDiskCache || r.FromServiceWorker,
+		timing:          r.Timing,
+	}
+}
+
+// NetworkRecorder collects the requests made by the page
+type NetworkRecorder struct {
+	sync.Mutex
+	c        *Chrome
+	requests []*NetworkRequest
+	pending  map[string]*NetworkRequest // unfinished requests by ID
+	off      []func()
+}
+
+// RecordNetwork starts collecting the requests made by the page until the
+// recorder is stopped
+func (c *Chrome) RecordNetwork() *NetworkRecorder {
+	r := &NetworkRecorder{c: c, pending: map[string]*NetworkRequest{}}
+	for method, f := range map[string]func(json.RawMessage){
+		"Network.requestWillBeSent": r.requestWillBeSent,
+		"Network.responseReceived":  r.responseReceived,
+		"Network.loadingFinished":   r.loadingFinished,
+		"Network.loadingFailed":     r.loadingFailed,
+	} {
+		r.off = append(r.off, c.On(method, f))
+	}
+	return r
+}
+
+// Stop stops collecting requests
+func (r *NetworkRecorder) Stop() {
+	r.Lock()
+	off := r.off
+	r.off = nil
+	r.Unlock()
+	for _, f := range off {
+		f()
+	}
+}
+
+// Requests returns copies of the requests collected so far, in the order
+// they have been sent
+func (r *NetworkRecorder) Requests() []NetworkRequest {
+	r.Lock()
+	defer r.Unlock()
+	requests := []NetworkRequest{}
+	for _, req := range r.requests {
+		requests = append(requests, *req)
+	}
+	return requests
+}
+
+// Body returns the body of a response. It is only available while the page
+// that made the request is loaded.
+func (r *NetworkRecorder) Body(id string) ([]byte, error) {
+	raw, err := r.c.Send("Network.getResponseBody", h{"requestId": id})
+	if err != nil {
+		return nil, err
+	}
+	res := struct {
+		Body          string `json:"body"`
+		Base64Encoded bool   `json:"base64Encoded"`
+	}{}
+	if err := json.Unmarshal(raw, &res); err != nil {
+		return nil, err
+	} else if res.Base64Encoded {
+		return base64.StdEncoding.DecodeString(res.Body)
+	}
+	return []byte(res.Body), nil
+}
+
+// finish must be called with r locked
+func (r *NetworkRecorder) finish(req *NetworkRequest, timestamp float64) {
+	req.Finished = true
+	// Timestamps have microsecond precision, round away float errors
+	req.Duration = time.Duration(math.Round((timestamp-req.timestamp)*1e6)) * time.Microsecond
+	delete(r.pending, req.ID)
+}
+
+func (r *NetworkRecorder) requestWillBeSent(params json.RawMessage) {
+	e := struct {
+		RequestID string `json:"requestId"`
+		Request   struct {
+			URL         string            `json:"url"`
+			URLFragment string            `json:"urlFragment"`
+			Method      string            `json:"method"`
+			Headers     map[string]string `json:"headers"`
+			PostData    string            `json:"postData"`
+		} `json:"request"`
+		Timestamp        float64          `json:"timestamp"`
+		WallTime         float64          `json:"wallTime"`
+		Type             string           `json:"type"`
+		RedirectResponse *networkResponse `json:"redirectResponse"`
+	}{}
+	if json.Unmarshal(params, &e) != nil {
+		return
+	}
+	r.Lock()
+	defer r.Unlock()
+	if prev, ok := r.pending[e.RequestID]; ok && e.RedirectResponse != nil {
+		prev.Response = e.RedirectResponse.response()
+		prev.Response.RedirectURL = e.Request.URL
+		prev.EncodedSize = int64(e.RedirectResponse.EncodedDataLength)
+		r.finish(prev, e.Timestamp)
+	}
+	req := &NetworkRequest{
+		ID:           e.RequestID,
+		URL:          e.Request.URL + e.Request.URLFragment,
+		Method:       e.Request.Method,
+		Headers:      e.Request.Headers,
+		PostData:     e.Request.PostData,
+		ResourceType: e.Type,
+		Started:      timestamp(e.WallTime * 1000),
+		timestamp:    e.Timestamp,
+	}
+	r.requests = append(r.requests, req)
+	r.pending[e.RequestID] = req
+}
+
+func (r *NetworkRecorder) responseReceived(params json.RawMessage) {
+	e := struct {
+		RequestID string          `json:"requestId"`
+		Response  networkResponse `json:"response"`
+	}{}
+	if json.Unmarshal(params, &e) != nil {
+		return
+	}
+	r.Lock()
+	defer r.Unlock()
+	if req, ok := r.pending[e.RequestID]; ok {
+		req.Response = e.Response.response()
+	}
+}
+
+func (r *NetworkRecorder) loadingFinished(params json.RawMessage) {
+	e := struct {
+		RequestID         string  `json:"requestId"`
+		Timestamp         float64 `json:"timestamp"`
+		EncodedDataLength float64 `json:"encodedDataLength"`
+	}{}
+	if json.Unmarshal(params, &e) != nil {
+		return
+	}
+	r.Lock()
+	defer r.Unlock()
+	if req, ok := r.pending[e.RequestID]; ok {
+		req.EncodedSize = int64(e.EncodedDataLength)
+		r.finish(req, e.Timestamp)
+	}
+}
+
+func (r *NetworkRecorder) loadingFailed(params json.RawMessage) {
+	e := struct {
+		RequestID string  `json:"requestId"`
+		Timestamp float64 `json:"timestamp"`
+		ErrorText string  `json:"errorText"`
+		Canceled  bool    `json:"canceled"`
+	}{}
+	if json.Unmarshal(params, &e) != nil {
+		return
+	}
+	r.Lock()
+	defer r.Unlock()
+	if req, ok := r.pending[e.RequestID]; ok {
+		req.Error = e.ErrorText
+		if req.Error == "" && e.Canceled {
+			req.Error = "canceled"
+		}
+		r.finish(req, e.Timestamp)
+	}
+}
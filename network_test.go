@@ -0,0 +1,84 @@
+package lorca
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestNetworkRecorder(t *testing.T) {
+	c := newChrome()
+	r := c.RecordNetwork()
+	for _, e := range []struct {
+		Method string
+		Params string
+	}{
+		{"Network.requestWillBeSent", `{"requestId":"1","request":{"url":"http://example.test/old?b=2&a=1","method":"GET","headers":{"Accept":"*/*"}},"timestamp":10,"wallTime":1600000000,"type":"Document"}`},
+		{"Network.requestWillBeSent", `{"requestId":"1","request":{"url":"https://example.test/new","urlFragment":"#top","method":"GET","headers":{"Accept":"*/*"}},"timestamp":10.1,"wallTime":1600000000.1,"type":"Document",
+			"redirectResponse":{"url":"http://example.test/old?b=2&a=1","status":301,"statusText":"Moved Permanently","headers":{"Location":"https://example.test/new"},"mimeType":"text/html","protocol":"http/1.1","encodedDataLength":120}}`},
+		{"Network.responseReceived", `{"requestId":"1","response":{"url":"https://example.test/new","status":200,"statusText":"OK","headers":{"Content-Type":"text/html","Set-Cookie":"a=1\nb=2"},"mimeType":"text/html","protocol":"h2","remoteIPAddress":"[::1]",
+			"timing":{"requestTime":10.1,"dnsStart":1,"dnsEnd":3,"connectStart":3,"connectEnd":10,"sslStart":5,"sslEnd":10,"sendStart":11,"sendEnd":12,"receiveHeadersEnd":40}}}`},
+		{"Network.requestWillBeSent", `{"requestId":"2","request":{"url":"http://nonexistent.invalid/","method":"POST","headers":{"Content-Type":"text/plain"},"postData":"ping"},"timestamp":10.2,"wallTime":1600000000.2,"type":"Fetch"}`},
+		{"Network.loadingFinished", `{"requestId":"1","timestamp":10.2,"encodedDataLength":1000}`},
+		{"Network.loadingFailed", `{"requestId":"2","timestamp":10.25,"errorText":"net::ERR_NAME_NOT_RESOLVED"}`},
+		{"Network.requestWillBeSent", `{"requestId":"3","request":{"url":"http://example.test/pending","method":"GET","headers":{}},"timestamp":10.3,"wallTime":1600000000.3,"type":"Image"}`},
+	} {
+		c.emit(e.Method, json.RawMessage(e.Params))
+	}
+	r.Stop()
+	c.emit("Network.loadingFinished", json.RawMessage(`{"requestId":"3","timestamp":11}`))
+
+	requests := r.Requests()
+	if len(requests) != 4 {
+		t.Fatal(requests)
+	}
+	if req := requests[0]; !req.Finished || req.Response.Status != 301 || req.Response.RedirectURL != "https://example.test/new" || req.EncodedSize != 120 {
+		t.Fatal(req, req.Response)
+	}
+	if req := requests[1]; !req.Finished || req.Response.Status != 200 || req.Duration != 100*time.Millisecond {
+		t.Fatal(req, req.Response)
+	}
+	if req := requests[2]; !req.Finished || req.Error != "net::ERR_NAME_NOT_RESOLVED" || req.Response != nil {
+		t.Fatal(req)
+	}
+	if req := requests[3]; req.Finished {
+		t.Fatal("recorder must not get events after Stop")
+	}
+
+	buf := &bytes.Buffer{}
+	if err := r.WriteHAR(buf, false); err != nil {
+		t.Fatal(err)
+	}
+	log := har{}
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatal(err)
+	}
+	if log.Log.Version != "1.2" || len(log.Log.Entries) != 3 {
+		t.Fatal(buf.String())
+	}
+	redirect, ok, failed := log.Log.Entries[0], log.Log.Entries[1], log.Log.Entries[2]
+	if redirect.StartedDateTime != "2020-09-13T12:26:40Z" || redirect.Response.RedirectURL != "https://example.test/new" ||
+		len(redirect.Request.QueryString) != 2 || redirect.Request.QueryString[0] != (harNameValue{"a", "1"}) ||
+		redirect.Request.HTTPVersion != "HTTP/1.1" {
+		t.Fatal(redirect)
+	}
+	if ok.Response.Status != 200 || ok.Response.HTTPVersion != "HTTP/2.0" || ok.Response.BodySize != 1000 || ok.ServerIPAddress != "::1" ||
+		len(ok.Response.Headers) != 3 || ok.Response.Headers[2] != (harNameValue{"Set-Cookie", "b=2"}) {
+		t.Fatal(ok)
+	}
+	if ok.Timings != (harTimings{Blocked: 1, DNS: 2, Connect: 7, SSL: 5, Send: 1, Wait: 28, Receive: 60}) || ok.Time != 99 {
+		t.Fatal(ok.Timings, ok.Time)
+	}
+	// HAR URLs have no fragment, like the URLs that MockHAR matches
+	if ok.Request.URL != "https://example.test/new" || requests[1].URL != "https://example.test/new#top" {
+		t.Fatal(ok.Request.URL, requests[1].URL)
+	}
+	if failed.Time != failed.Timings.Wait || failed.Time != 50 {
+		t.Fatal(failed.Time, failed.Timings)
+	}
+	if failed.Comment != "net::ERR_NAME_NOT_RESOLVED" || failed.Request.PostData == nil ||
+		*failed.Request.PostData != (harPostData{"text/plain", "ping"}) || failed.Response.Status != 0 {
+		t.Fatal(failed)
+	}
+}
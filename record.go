@@ -0,0 +1,181 @@
+package lorca
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sync"
+)
+
+// frame is a single line of a recording made by NewRecorder
+type frame struct {
+	// Dir is either "send" (lorca to browser) or "recv" (browser to lorca)
+	Dir  string          `json:"dir"`
+	Data json.RawMessage `json:"data"`
+}
+
+type recorder struct {
+	Transport
+	sync.Mutex
+	enc *json.Encoder
+}
+
+// NewRecorder wraps a transport and writes every message sent or received
+// through it to w, one JSON object per line. The recording can be played back
+// with NewReplayer.
+func NewRecorder(t Transport, w io.Writer) Transport {
+	return &recorder{Transport: t, enc: json.NewEncoder(w)}
+}
+
+func (r *recorder) record(dir string, msg []byte) {
+	r.Lock()
+	defer r.Unlock()
+	r.enc.Encode(frame{Dir: dir, Data: msg})
+}
+
+func (r *recorder) Send(msg []byte) error {
+	r.record("send", msg)
+	return r.Transport.Send(msg)
+}
+
+func (r *recorder) Receive() ([]byte, error) {
+	msg, err := r.Transport.Receive()
+	if err == nil {
+		r.record("recv", msg)
+	}
+	return msg, err
+}
+
+// request is the part of an outgoing message that is compared when replaying
+type request struct {
+	ID        int             `json:"id"`
+	Method    string          `json:"method"`
+	Params    json.RawMessage `json:"params"`
+	SessionID string          `json:"sessionId"`
+}
+
+func (r request) matches(other request) bool {
+	if r.Method != other.Method || r.SessionID != other.SessionID {
+		return false
+	}
+	var a, b interface{}
+	json.Unmarshal(r.Params, &a)
+	json.Unmarshal(other.Params, &b)
+	return reflect.DeepEqual(a, b)
+}
+
+type replayFrame struct {
+	frame
+	req  request
+	done bool // sends: matched by the client, recvs: delivered
+}
+
+type replayer struct {
+	sync.Mutex
+	cond   *sync.Cond
+	frames []*replayFrame
+	ids    map[int]int // recorded request ID -> replayed request ID
+	closed bool
+}
+
+// NewReplayer returns a transport that plays back a recording made with
+// NewRecorder instead of talking to a real browser. Each message sent through
+// it must be equal to some recorded one, apart from the request ID. Recorded
+// responses are delivered once their request has been sent, recorded events
+// once all the messages sent before them have been sent. Sending an
+// unrecorded message fails.
+func NewReplayer(r io.Reader) (Transport, error) {
+	rp := &replayer{ids: map[int]int{}}
+	rp.cond = sync.NewCond(rp)
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, 64*1024*1024)
+	for s.Scan() {
+		if len(s.Bytes()) == 0 {
+			continue
+		}
+		f := &replayFrame{}
+		if err := json.Unmarshal(s.Bytes(), &f.frame); err != nil {
+			return nil, err
+		}
+		if f.Dir != "send" && f.Dir != "recv" {
+			return nil, fmt.Errorf("unknown frame direction %q", f.Dir)
+		}
+		if err := json.Unmarshal(f.Data, &f.req); err != nil {
+			return nil, err
+		}
+		rp.frames = append(rp.frames, f)
+	}
+	return rp, s.Err()
+}
+
+func (rp *replayer) Send(msg []byte) error {
+	req := request{}
+	if err := json.Unmarshal(msg, &req); err != nil {
+		return err
+	}
+	rp.Lock()
+	defer rp.Unlock()
+	if rp.closed {
+		return errors.New("replayer closed")
+	}
+	for _, f := range rp.frames {
+		if f.Dir == "send" && !f.done && f.req.matches(req) {
+			f.done = true
+			rp.ids[f.req.ID] = req.ID
+			rp.cond.Broadcast()
+			return nil
+		}
+	}
+	return fmt.Errorf("no recorded message matches %s", msg)
+}
+
+// ready tells whether the recv frame at index i can be delivered
+func (rp *replayer) ready(i int) bool {
+	f := rp.frames[i]
+	if f.req.Method == "" {
+		_, ok := rp.ids[f.req.ID]
+		return ok
+	}
+	for _, prev := range rp.frames[:i] {
+		if prev.Dir == "send" && !prev.done {
+			return false
+		}
+	}
+	return true
+}
+
+func (rp *replayer) Receive() ([]byte, error) {
+	rp.Lock()
+	defer rp.Unlock()
+	for !rp.closed {
+		for i, f := range rp.frames {
+			if f.Dir != "recv" || f.done || !rp.ready(i) {
+				continue
+			}
+			f.done = true
+			if f.req.Method != "" {
+				return f.Data, nil
+			}
+			// Responses carry the ID of the replayed request
+			m := map[string]json.RawMessage{}
+			if err := json.Unmarshal(f.Data, &m); err != nil {
+				return nil, err
+			}
+			m["id"], _ = json.Marshal(rp.ids[f.req.ID])
+			return json.Marshal(m)
+		}
+		rp.cond.Wait()
+	}
+	return nil, io.EOF
+}
+
+func (rp *replayer) Close() error {
+	rp.Lock()
+	defer rp.Unlock()
+	rp.closed = true
+	rp.cond.Broadcast()
+	return nil
+}
@@ -0,0 +1,127 @@
+package lorca
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"testing"
+)
+
+// The fixtures in testdata are sessions recorded with a real browser. Run the
+// replay tests with -record to record them again with the browser found by
+// LocateChrome:
+//
+//	go test -run Replay -record
+var recordFixtures = flag.Bool("record", false, "record the replay fixtures with a real browser")
+
+// replay returns a browser that plays back the fixture at path, or records it
+// with -record
+func replay(t *testing.T, path string) *Chrome {
+	if *recordFixtures {
+		return record(t, path)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	r, err := NewReplayer(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewChromeWithTransport(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+// record starts a browser that records the whole session to path
+func record(t *testing.T, path string) *Chrome {
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The window is recorded too, so the browser is not started with the
+	// plain --headless flag
+	c, err := RecordChromeWithArgs(f, LocateChrome(), "--user-data-dir="+t.TempDir(),
+		"--remote-debugging-port=0", "--headless=new")
+	if err != nil {
+		f.Close()
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		c.Kill()
+		<-c.exited
+		f.Close()
+	})
+	return c
+}
+
+func TestReplay(t *testing.T) {
+	c := replay(t, "testdata/eval.jsonl")
+	defer c.Kill()
+
+	if c.window == 0 {
+		t.Fatal(c.window)
+	}
+	for _, test := range []struct {
+		Expr   string
+		Result string
+		Error  string
+	}{
+		{Expr: `2+3`, Result: `5`},
+		{Expr: `(() => ({x: 5, y: 7}))()`, Result: `{"x":5,"y":7}`},
+		{Expr: `throw "bar"`, Error: `bar`},
+	} {
+		result, err := c.Eval(test.Expr)
+		if err != nil {
+			if err.Error() != test.Error {
+				t.Fatal(test.Expr, err, test.Error)
+			}
+		} else if string(result) != test.Result {
+			t.Fatal(test.Expr, string(result), test.Result)
+		}
+	}
+	if *recordFixtures {
+		return
+	}
+	if _, err := c.Eval(`1+1`); err == nil {
+		t.Fatal("unrecorded message must fail")
+	}
+}
+
+func TestRecord(t *testing.T) {
+	f, err := os.Open("testdata/eval.jsonl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	r, err := NewReplayer(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := &bytes.Buffer{}
+	c, err := NewChromeWithTransport(NewRecorder(r, buf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res, err := c.Eval(`2+3`); err != nil || string(res) != `5` {
+		t.Fatal(string(res), err)
+	}
+	c.Kill()
+
+	// The new recording must be replayable on its own
+	r, err = NewReplayer(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err = NewChromeWithTransport(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Kill()
+	if res, err := c.Eval(`2+3`); err != nil || string(res) != `5` {
+		t.Fatal(string(res), err)
+	}
+}
@@ -0,0 +1,165 @@
+package lorca
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+
+	"github.com/kjk/lorca/cdp"
+)
+
+// ScreenshotOptions configures Screenshot. The zero value captures the
+// viewport as a PNG image.
+type ScreenshotOptions struct {
+	// Format is "png", "jpeg" or "webp", "png" by default
+	Format string
+	// Quality is the compression quality of jpeg and webp images, from 1 to
+	// 100, zero uses the default of the browser
+	Quality int
+	// FullPage captures the whole scrollable page instead of the viewport
+	FullPage bool
+	// Clip captures a region of the page, in CSS pixels relative to the
+	// document
+	Clip *Rect
+	// Element and Selector capture an element, which is scrolled into view
+	Element  *Element
+	Selector string
+	// Scale is the device scale factor of the image, 1 by default
+	Scale float64
+	// OmitBackground makes the default white background of the page
+	// transparent
+	OmitBackground bool
+	// Background replaces the default white background of the page, if not
+	// zero. It is in ARGB format.
+	Background uint32
+}
+
+// Screenshot captures the page as an image. The default background of the
+// page is restored after the capture.
+func (c *Chrome) Screenshot(opts *ScreenshotOptions) (img []byte, err error) {
+	if opts == nil {
+		opts = &ScreenshotOptions{}
+	}
+	params := &cdp.PageCaptureScreenshotParams{}
+	switch opts.Format {
+	case "", "png":
+		params.Format = "png"
+	case "jpeg", "webp":
+		params.Format = opts.Format
+		if opts.Quality > 0 {
+			params.Quality = cdp.Int(opts.Quality)
+		}
+	default:
+		return nil, errors.New("unknown screenshot format: " + opts.Format)
+	}
+	clip, err := c.screenshotClip(opts)
+	if err != nil {
+		return nil, err
+	}
+	if clip != nil {
+		scale := opts.Scale
+		if scale == 0 {
+			scale = 1
+		}
+		params.Clip = &cdp.PageViewport{X: clip.X, Y: clip.Y, Width: clip.Width, Height: clip.Height, Scale: scale}
+	}
+	if opts.FullPage {
+		params.CaptureBeyondViewport = cdp.Bool(true)
+	}
+	if opts.Background != 0 || opts.OmitBackground {
+		bg := opts.Background
+		if _, err := c.Send("Emulation.setDefaultBackgroundColorOverride", h{
+			"color": h{
+				"r": (bg >> 16) & 0xff,
+				"g": (bg >> 8) & 0xff,
+				"b": bg & 0xff,
+				"a": (bg >> 24) & 0xff,
+			},
+		}); err != nil {
+			return nil, err
+		}
+		defer func() {
+			// Without a color the override is cleared
+			if _, restoreErr := c.Send("Emulation.setDefaultBackgroundColorOverride", nil); err == nil {
+				err = restoreErr
+			}
+		}()
+	}
+	res, err := cdp.Page{Client: c}.CaptureScreenshot(context.Background(), params)
+	if err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(res.Data)
+}
+
+// screenshotClip returns the region to capture, or nil for the viewport
+func (c *Chrome) screenshotClip(opts *ScreenshotOptions) (*Rect, error) {
+	switch {
+	case opts.Element != nil:
+		r, err := opts.Element.scrollIntoView(true)
+		return &r, err
+	case opts.Selector != "":
+		e, err := c.Query(opts.Selector)
+		if err != nil {
+			return nil, err
+		} else if e == nil {
+			return nil, errors.New("no element matches selector: " + opts.Selector)
+		}
+		defer e.Release()
+		r, err := e.scrollIntoView(true)
+		return &r, err
+	case opts.Clip != nil:
+		r := *opts.Clip
+		return &r, nil
+	case opts.FullPage:
+		m, err := c.layoutMetrics()
+		return &Rect{Width: m.Content.Width, Height: m.Content.Height}, err
+	case opts.Scale != 0 && opts.Scale != 1:
+		// The scale only applies to clipped screenshots
+		m, err := c.layoutMetrics()
+		v := m.Viewport
+		return &Rect{X: v.PageX, Y: v.PageY, Width: v.ClientWidth, Height: v.ClientHeight}, err
+	}
+	return nil, nil
+}
+
+type layoutMetrics struct {
+	Content  Rect
+	Viewport struct {
+		PageX        float64 `json:"pageX"`
+		PageY        float64 `json:"pageY"`
+		ClientWidth  float64 `json:"clientWidth"`
+		ClientHeight float64 `json:"clientHeight"`
+	}
+}
+
+// layoutMetrics returns the size of the page and the visual viewport in CSS
+// pixels
+func (c *Chrome) layoutMetrics() (layoutMetrics, error) {
+	m := layoutMetrics{}
+	raw, err := c.Send("Page.getLayoutMetrics", nil)
+	if err != nil {
+		return m, err
+	}
+	res := struct {
+		CSSContentSize    *Rect           `json:"cssContentSize"`
+		ContentSize       Rect            `json:"contentSize"`
+		CSSVisualViewport json.RawMessage `json:"cssVisualViewport"`
+		VisualViewport    json.RawMessage `json:"visualViewport"`
+	}{}
+	if err := json.Unmarshal(raw, &res); err != nil {
+		return m, err
+	}
+	// The CSS pixel sizes are missing in older browsers
+	m.Content = res.ContentSize
+	if res.CSSContentSize != nil {
+		m.Content = *res.CSSContentSize
+	}
+	viewport := res.VisualViewport
+	if res.CSSVisualViewport != nil {
+		viewport = res.CSSVisualViewport
+	}
+	err = json.Unmarshal(viewport, &m.Viewport)
+	return m, err
+}
@@ -0,0 +1,27 @@
+package lorca
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestReplayScreenshot(t *testing.T) {
+	c := replay(t, "testdata/screenshot.jsonl")
+	defer c.Kill()
+
+	if _, err := c.Screenshot(&ScreenshotOptions{Format: "gif"}); err == nil {
+		t.Fatal("unknown format must fail")
+	}
+	img, err := c.Screenshot(&ScreenshotOptions{Format: "webp", Quality: 80, FullPage: true, OmitBackground: true})
+	if err != nil || !bytes.HasPrefix(img, []byte("RIFF")) {
+		t.Fatal(len(img), err)
+	}
+	img, err = c.Screenshot(&ScreenshotOptions{Format: "jpeg", Scale: 0.5})
+	if err != nil || !bytes.HasPrefix(img, []byte("\xff\xd8\xff")) {
+		t.Fatal(len(img), err)
+	}
+	img, err = c.PNG(10, 20, 30, 40, 0xff112233, 2)
+	if err != nil || !bytes.HasPrefix(img, []byte("\x89PNG")) {
+		t.Fatal(len(img), err)
+	}
+}
@@ -0,0 +1,50 @@
+package lorca
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// responseWriter collects the response of a handler passed to ServeHandler
+type responseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (w *responseWriter) Header() http.Header { return w.header }
+
+func (w *responseWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func (w *responseWriter) Write(b []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	return w.body.Write(b)
+}
+
+// ServeHandler answers all the requests for a virtual origin like
+// "https://app.local" with the handler. Requests are intercepted in the
+// browser with the Fetch domain, so no socket is opened and no other process
+// can reach the handler. The returned function stops serving.
+func (c *Chrome) ServeHandler(origin string, handler http.Handler) (func(), error) {
+	u, err := url.Parse(origin)
+	if err != nil {
+		return nil, err
+	} else if u.Scheme == "" || u.Host == "" || strings.Trim(u.Path, "/") != "" {
+		return nil, errors.New("origin must be a scheme and a host, like https://app.local")
+	}
+	prefix := u.Scheme + "://" + u.Host
+	return c.addRoute(route{
+		patterns: []string{prefix + "/*"},
+		match: func(url string) bool {
+			return url == prefix || strings.HasPrefix(url, prefix+"/")
+		},
+		handle: c.mockHandle(MockHandler(handler)),
+	})
+}
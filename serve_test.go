@@ -0,0 +1,41 @@
+package lorca
+
+import (
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestReplayServeHandler(t *testing.T) {
+	c := replay(t, "testdata/serve.jsonl")
+	defer c.Kill()
+
+	if _, err := c.ServeHandler("app.local", http.NotFoundHandler()); err == nil {
+		t.Fatal("origin without a scheme must fail")
+	}
+
+	stop, err := c.ServeHandler("https://app.local/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if r.Host != "app.local" || (r.Method == http.MethodPost && r.Header.Get("Content-Type") != "text/plain") {
+			t.Error(r.Host, r.Header)
+		}
+		w.Header().Set("Content-Type", "text/plain")
+		w.Header().Add("X-Test", "a")
+		w.Header().Add("X-Test", "b")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(r.Method + " " + r.RequestURI + " " + string(body)))
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.LoadAndWait("https://app.local/", WaitLoad, 5*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	res, err := c.Eval(`fetch('/hello?x=1', {method: 'POST', headers: {'Content-Type': 'text/plain'}, body: 'ping'})
+		.then(async r => [r.status, r.headers.get('X-Test'), await r.text()])`)
+	if err != nil || string(res) != `[201,"a, b","POST /hello?x=1 ping"]` {
+		t.Fatal(string(res), err)
+	}
+	stop()
+}
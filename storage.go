@@ -0,0 +1,264 @@
+package lorca
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Cookie is a browser cookie
+type Cookie struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+	// URL is only used when setting or deleting cookies, the domain and path
+	// default to those of the URL
+	URL    string `json:"url,omitempty"`
+	Domain string `json:"domain,omitempty"`
+	Path   string `json:"path,omitempty"`
+	// Expires is zero for session cookies
+	Expires  time.Time `json:"expires"`
+	HTTPOnly bool      `json:"httpOnly,omitempty"`
+	Secure   bool      `json:"secure,omitempty"`
+	// SameSite is "Strict", "Lax", "None" or empty
+	SameSite string `json:"sameSite,omitempty"`
+}
+
+// cookie is Network.Cookie and Network.CookieParam, expires is in seconds
+// since the epoch
+type cookie struct {
+	Name     string  `json:"name"`
+	Value    string  `json:"value"`
+	URL      string  `json:"url,omitempty"`
+	Domain   string  `json:"domain,omitempty"`
+	Path     string  `json:"path,omitempty"`
+	Expires  float64 `json:"expires,omitempty"`
+	HTTPOnly bool    `json:"httpOnly,omitempty"`
+	Secure   bool    `json:"secure,omitempty"`
+	SameSite string  `json:"sameSite,omitempty"`
+	Session  bool    `json:"session,omitempty"`
+}
+
+func (ck cookie) cookie() Cookie {
+	c := Cookie{
+		Name:     ck.Name,
+		Value:    ck.Value,
+		Domain:   ck.Domain,
+		Path:     ck.Path,
+		HTTPOnly: ck.HTTPOnly,
+		Secure:   ck.Secure,
+		SameSite: ck.SameSite,
+	}
+	if !ck.Session && ck.Expires > 0 {
+		c.Expires = timestamp(ck.Expires * 1000).UTC()
+	}
+	return c
+}
+
+func cookieParam(c Cookie) cookie {
+	ck := cookie{
+		Name:     c.Name,
+		Value:    c.Value,
+		URL:      c.URL,
+		Domain:   c.Domain,
+		Path:     c.Path,
+		HTTPOnly: c.HTTPOnly,
+		Secure:   c.Secure,
+		SameSite: c.SameSite,
+	}
+	if !c.Expires.IsZero() {
+		ck.Expires = float64(c.Expires.UnixNano()) / float64(time.Second)
+	}
+	return ck
+}
+
+// Cookies returns the cookies that would be sent to the URLs, or all the
+// cookies of the browser if no URL is given
+func (c *Chrome) Cookies(urls ...string) ([]Cookie, error) {
+	var raw json.RawMessage
+	var err error
+	if len(urls) > 0 {
+		raw, err = c.Send("Network.getCookies", h{"urls": urls})
+	} else {
+		raw, err = c.Send("Network.getAllCookies", nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+	res := struct {
+		Cookies []cookie `json:"cookies"`
+	}{}
+	if err := json.Unmarshal(raw, &res); err != nil {
+		return nil, err
+	}
+	cookies := []Cookie{}
+	for _, ck := range res.Cookies {
+		cookies = append(cookies, ck.cookie())
+	}
+	return cookies, nil
+}
+
+// SetCookies sets cookies, replacing the existing ones with the same name,
+// domain and path. Each cookie needs either a URL or a domain.
+func (c *Chrome) SetCookies(cookies ...Cookie) error {
+	params := []cookie{}
+	for _, ck := range cookies {
+		params = append(params, cookieParam(ck))
+	}
+	_, err := c.Send("Network.setCookies", h{"cookies": params})
+	return err
+}
+
+// DeleteCookies deletes the cookies with the name of each of the given
+// cookies, that match its URL, domain and path where these are set
+func (c *Chrome) DeleteCookies(cookies ...Cookie) error {
+	for _, ck := range cookies {
+		params := h{"name": ck.Name}
+		for name, value := range map[string]string{"url": ck.URL, "domain": ck.Domain, "path": ck.Path} {
+			if value != "" {
+				params[name] = value
+			}
+		}
+		if _, err := c.Send("Network.deleteCookies", params); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StorageArea is the Web Storage area of an origin
+type StorageArea int
+
+// Storage areas
+const (
+	LocalStorage StorageArea = iota
+	SessionStorage
+)
+
+func storageID(origin string, area StorageArea) h {
+	return h{"securityOrigin": strings.TrimSuffix(origin, "/"), "isLocalStorage": area == LocalStorage}
+}
+
+// Storage returns the items of a storage area of an origin, like
+// "https://example.com". The origin must be loaded in the page or one of its
+// frames.
+func (c *Chrome) Storage(origin string, area StorageArea) (map[string]string, error) {
+	raw, err := c.Send("DOMStorage.getDOMStorageItems", h{"storageId": storageID(origin, area)})
+	if err != nil {
+		return nil, err
+	}
+	res := struct {
+		Entries [][]string `json:"entries"`
+	}{}
+	if err := json.Unmarshal(raw, &res); err != nil {
+		return nil, err
+	}
+	items := map[string]string{}
+	for _, e := range res.Entries {
+		if len(e) == 2 {
+			items[e[0]] = e[1]
+		}
+	}
+	return items, nil
+}
+
+// SetStorage sets items of a storage area of an origin, see Storage
+func (c *Chrome) SetStorage(origin string, area StorageArea, items map[string]string) error {
+	keys := []string{}
+	for key := range items {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if _, err := c.Send("DOMStorage.setDOMStorageItem", h{
+			"storageId": storageID(origin, area),
+			"key":       key,
+			"value":     items[key],
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RemoveStorage removes items of a storage area of an origin, or all of them
+// if no key is given, see Storage
+func (c *Chrome) RemoveStorage(origin string, area StorageArea, keys ...string) error {
+	if len(keys) == 0 {
+		_, err := c.Send("DOMStorage.clear", h{"storageId": storageID(origin, area)})
+		return err
+	}
+	for _, key := range keys {
+		if _, err := c.Send("DOMStorage.removeDOMStorageItem", h{"storageId": storageID(origin, area), "key": key}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClearSiteData clears the data stored by an origin. Types are
+// Storage.StorageType values like "cookies", "local_storage", "indexeddb",
+// "cache_storage" or "service_workers", all data is cleared if none is given.
+func (c *Chrome) ClearSiteData(origin string, types ...string) error {
+	if len(types) == 0 {
+		types = []string{"all"}
+	}
+	_, err := c.Send("Storage.clearDataForOrigin", h{
+		"origin":       strings.TrimSuffix(origin, "/"),
+		"storageTypes": strings.Join(types, ","),
+	})
+	return err
+}
+
+// browserState is the state written by ExportState
+type browserState struct {
+	Cookies []Cookie      `json:"cookies"`
+	Origins []originState `json:"origins"`
+}
+
+type originState struct {
+	Origin       string            `json:"origin"`
+	LocalStorage map[string]string `json:"localStorage"`
+}
+
+// ExportState writes all the cookies and the local storage of the origins to
+// w as JSON, so that the session can be restored with ImportState, possibly
+// in another profile. The origins must be loaded, see Storage.
+func (c *Chrome) ExportState(w io.Writer, origins ...string) error {
+	cookies, err := c.Cookies()
+	if err != nil {
+		return err
+	}
+	state := browserState{Cookies: cookies, Origins: []originState{}}
+	for _, origin := range origins {
+		items, err := c.Storage(origin, LocalStorage)
+		if err != nil {
+			return err
+		}
+		state.Origins = append(state.Origins, originState{Origin: origin, LocalStorage: items})
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(state)
+}
+
+// ImportState restores the state written by ExportState. The origins of the
+// local storage must be loaded, see Storage.
+func (c *Chrome) ImportState(r io.Reader) error {
+	state := browserState{}
+	if err := json.NewDecoder(r).Decode(&state); err != nil {
+		return err
+	}
+	if len(state.Cookies) > 0 {
+		if err := c.SetCookies(state.Cookies...); err != nil {
+			return err
+		}
+	}
+	for _, o := range state.Origins {
+		if err := c.SetStorage(o.Origin, LocalStorage, o.LocalStorage); err != nil {
+			return err
+		}
+	}
+	return nil
+}
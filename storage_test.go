@@ -0,0 +1,87 @@
+package lorca
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReplayStorage(t *testing.T) {
+	c := replay(t, "testdata/storage.jsonl")
+	defer c.Kill()
+
+	if _, err := c.ServeHandler("https://app.local", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Set-Cookie", "sid=abc; Max-Age=86400; Path=/; Secure; HttpOnly; SameSite=Lax")
+		w.Header().Add("Set-Cookie", "theme=dark; Path=/; Secure")
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<script>localStorage.setItem('token', 't1'); localStorage.setItem('user', 'ann')</script>`))
+	})); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.LoadAndWait("https://app.local/", WaitLoad, 5*time.Second); err != nil {
+		t.Fatal(err)
+	}
+
+	state := &bytes.Buffer{}
+	if err := c.ExportState(state, "https://app.local"); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(state.String(), "{\n  \"cookies\": [\n") {
+		t.Fatal(state.String())
+	}
+	exported := browserState{}
+	if err := json.Unmarshal(state.Bytes(), &exported); err != nil {
+		t.Fatal(err)
+	}
+	cookies := map[string]Cookie{}
+	for _, ck := range exported.Cookies {
+		cookies[ck.Name] = ck
+	}
+	sid, theme := cookies["sid"], cookies["theme"]
+	if len(cookies) != 2 || sid.Value != "abc" || sid.Domain != "app.local" || sid.Path != "/" ||
+		sid.Expires.IsZero() || !sid.HTTPOnly || !sid.Secure || sid.SameSite != "Lax" ||
+		theme.Value != "dark" || !theme.Expires.IsZero() || theme.HTTPOnly || !theme.Secure {
+		t.Fatal(state.String())
+	}
+	if o := exported.Origins; len(o) != 1 || o[0].Origin != "https://app.local" ||
+		!reflect.DeepEqual(o[0].LocalStorage, map[string]string{"token": "t1", "user": "ann"}) {
+		t.Fatal(state.String())
+	}
+	if err := c.ImportState(state); err != nil {
+		t.Fatal(err)
+	}
+
+	if imported, err := c.Cookies("https://app.local/"); err != nil || len(imported) != 2 {
+		t.Fatal(imported, err)
+	}
+	if err := c.DeleteCookies(Cookie{Name: "theme", URL: "https://app.local/"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.RemoveStorage("https://app.local/", SessionStorage); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Storage("https://other.local", LocalStorage); err == nil || err.Error() != "Frame not found for the given storage id" {
+		t.Fatal(err)
+	}
+	if err := c.ClearSiteData("https://app.local/", "cookies", "local_storage"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCookieParam(t *testing.T) {
+	expires := time.Date(2027, 1, 15, 8, 0, 0, 0, time.UTC)
+	ck := cookieParam(Cookie{Name: "a", Value: "b", URL: "https://app.local/", Expires: expires})
+	if ck.Expires != 1800000000 || ck.URL != "https://app.local/" || ck.Session {
+		t.Fatal(ck)
+	}
+	if c := ck.cookie(); !c.Expires.Equal(expires) || c.Name != "a" || c.Value != "b" {
+		t.Fatal(c)
+	}
+	if c := (cookie{Name: "a", Expires: -1, Session: true}).cookie(); !c.Expires.IsZero() {
+		t.Fatal(c)
+	}
+}
@@ -0,0 +1,173 @@
+package lorca
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"runtime"
+	"sync"
+
+	"golang.org/x/net/websocket"
+)
+
+// Transport carries DevTools protocol messages between lorca and the browser.
+// Each call to Send or Receive transfers exactly one JSON message. Send may be
+// called concurrently with itself and with Receive.
+type Transport interface {
+	Send(msg []byte) error
+	Receive() ([]byte, error)
+	Close() error
+}
+
+// wsTransport talks to the browser over the websocket opened by
+// --remote-debugging-port
+type wsTransport struct {
+	*websocket.Conn
+}
+
+// dialWebsocket opens a websocket to a DevTools URL, giving up once ctx is
+// done
+func dialWebsocket(ctx context.Context, url string) (wsTransport, error) {
+	config, err := websocket.NewConfig(url, "http://127.0.0.1")
+	if err != nil {
+		return wsTransport{}, err
+	}
+	u := config.Location
+	addr := u.Host
+	if u.Port() == "" {
+		port := "80"
+		if u.Scheme == "wss" {
+			port = "443"
+		}
+		addr = net.JoinHostPort(u.Hostname(), port)
+	}
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return wsTransport{}, err
+	}
+	if u.Scheme == "wss" {
+		conn = tls.Client(conn, &tls.Config{ServerName: u.Hostname()})
+	}
+	// The handshake waits for an answer that a stuck browser never sends
+	stop := closeOnDone(ctx, conn)
+	ws, err := websocket.NewClient(config, conn)
+	if stop() {
+		return wsTransport{}, ctx.Err()
+	} else if err != nil {
+		conn.Close()
+		return wsTransport{}, err
+	}
+	return wsTransport{ws}, nil
+}
+
+// closeOnDone closes c if ctx is done before the returned function is called.
+// That function tells whether c has been closed.
+func closeOnDone(ctx context.Context, c io.Closer) func() bool {
+	done := make(chan struct{})
+	closed := make(chan bool, 1)
+	go func() {
+		select {
+		case <-ctx.Done():
+			c.Close()
+			closed <- true
+		case <-done:
+			closed <- false
+		}
+	}()
+	return func() bool {
+		close(done)
+		return <-closed
+	}
+}
+
+// Send sends msg as a text frame, Chrome ignores binary ones
+func (t wsTransport) Send(msg []byte) error { return websocket.Message.Send(t.Conn, string(msg)) }
+
+func (t wsTransport) Receive() (msg []byte, err error) {
+	err = websocket.Message.Receive(t.Conn, &msg)
+	return msg, err
+}
+
+// pipeTransport talks to the browser over the file descriptors 3 and 4 opened
+// by --remote-debugging-pipe. Messages are delimited with a NUL byte.
+type pipeTransport struct {
+	sync.Mutex
+	w io.WriteCloser
+	r io.ReadCloser
+	b *bufio.Reader
+}
+
+func newPipeTransport(w io.WriteCloser, r io.ReadCloser) *pipeTransport {
+	return &pipeTransport{w: w, r: r, b: bufio.NewReader(r)}
+}
+
+func (t *pipeTransport) Send(msg []byte) error {
+	if bytes.IndexByte(msg, 0) >= 0 {
+		return errors.New("message contains a NUL byte")
+	}
+	t.Lock()
+	defer t.Unlock()
+	_, err := t.w.Write(append(msg[:len(msg):len(msg)], 0))
+	return err
+}
+
+func (t *pipeTransport) Receive() ([]byte, error) {
+	msg, err := t.b.ReadBytes(0)
+	if err != nil {
+		return nil, err
+	}
+	return msg[:len(msg)-1], nil
+}
+
+func (t *pipeTransport) Close() error {
+	werr := t.w.Close()
+	if err := t.r.Close(); err != nil {
+		return err
+	}
+	return werr
+}
+
+// startPipe starts the browser process with two extra pipes attached, the
+// browser reads commands from the first one and writes responses to the
+// second one.
+func startPipe(c *Chrome) (Transport, error) {
+	if runtime.GOOS == "windows" {
+		return nil, errors.New("--remote-debugging-pipe is not supported on windows")
+	}
+	cmdr, cmdw, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+	resr, resw, err := os.Pipe()
+	if err != nil {
+		cmdr.Close()
+		cmdw.Close()
+		return nil, err
+	}
+	c.Cmd.ExtraFiles = []*os.File{cmdr, resw}
+	err = c.Cmd.Start()
+	// The child process has its own copies now
+	cmdr.Close()
+	resw.Close()
+	if err != nil {
+		cmdw.Close()
+		resr.Close()
+		return nil, err
+	}
+	return newPipeTransport(cmdw, resr), nil
+}
+
+// remoteDebuggingArg returns the flag that enables the DevTools protocol.
+// Pipes are preferred, because unlike a TCP port they can't be reached by
+// other local processes.
+func remoteDebuggingArg() string {
+	if runtime.GOOS == "windows" {
+		return "--remote-debugging-port=0"
+	}
+	return "--remote-debugging-pipe"
+}
@@ -0,0 +1,32 @@
+package lorca
+
+import (
+	"io"
+	"testing"
+)
+
+func TestPipeTransport(t *testing.T) {
+	// Loop the transport back to itself
+	r, w := io.Pipe()
+	p := newPipeTransport(w, r)
+	defer p.Close()
+
+	msgs := []string{`{"id":1}`, `{"method":"Page.loadEventFired","params":{}}`, `{}`}
+	go func() {
+		for _, m := range msgs {
+			if err := p.Send([]byte(m)); err != nil {
+				t.Error(err)
+			}
+		}
+	}()
+	for _, want := range msgs {
+		if got, err := p.Receive(); err != nil {
+			t.Fatal(err)
+		} else if string(got) != want {
+			t.Fatal(string(got), want)
+		}
+	}
+	if err := p.Send([]byte("a\x00b")); err == nil {
+		t.Fatal("NUL byte must be rejected")
+	}
+}
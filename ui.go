@@ -1,6 +1,7 @@
 package lorca
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -63,7 +64,7 @@ func New(chromeExe, url, userDataDir string, width, height int, customArgs ...st
 	args = append(args, fmt.Sprintf("--user-data-dir=%s", userDataDir))
 	args = append(args, fmt.Sprintf("--window-size=%d,%d", width, height))
 	args = append(args, customArgs...)
-	args = append(args, "--remote-debugging-port=0")
+	args = append(args, remoteDebuggingArg())
 
 	chrome, err := NewChromeWithArgs(chromeExe, args...)
 	done := make(chan struct{})
@@ -72,12 +73,16 @@ func New(chromeExe, url, userDataDir string, width, height int, customArgs ...st
 	}
 
 	go func() {
-		chrome.Cmd.Wait()
+		<-chrome.exited
+		chrome.shutdown(chrome.exitReason())
 		close(done)
 	}()
 	return &UI{Chrome: chrome, done: done, tmpDir: tmpDir}, nil
 }
 
+// Done returns a channel that is closed when the browser process has exited.
+// After that Reason tells whether the window was closed by the user, the
+// browser crashed or Kill was called.
 func (u *UI) Done() <-chan struct{} {
 	return u.done
 }
@@ -148,6 +153,32 @@ func (u *UI) Bind(name string, f interface{}) error {
 }
 
 func (u *UI) Eval(js string) Value {
-	v, err := u.Chrome.Eval(js)
+	return u.EvalContext(context.Background(), js)
+}
+
+// EvalContext is like Eval, but the returned value holds ctx.Err() if ctx is
+// done before the expression has been evaluated.
+func (u *UI) EvalContext(ctx context.Context, js string) Value {
+	v, err := u.Chrome.EvalContext(ctx, js)
+	return value{err: err, raw: v}
+}
+
+// Call calls a JavaScript function declaration with the given Go arguments,
+// see Chrome.Call
+func (u *UI) Call(fn string, args ...interface{}) Value {
+	return u.CallContext(context.Background(), fn, args...)
+}
+
+// CallContext is like Call, but the returned value holds ctx.Err() if ctx is
+// done before the function has returned.
+func (u *UI) CallContext(ctx context.Context, fn string, args ...interface{}) Value {
+	v, err := u.Chrome.CallContext(ctx, fn, args...)
+	return value{err: err, raw: v}
+}
+
+// WaitForFunction waits until the JavaScript expression is truthy and returns
+// its value, see Chrome.WaitForFunction
+func (u *UI) WaitForFunction(expr string, opts *WaitOptions) Value {
+	v, err := u.Chrome.WaitForFunction(expr, opts)
 	return value{err: err, raw: v}
 }
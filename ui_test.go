@@ -25,8 +25,7 @@ func TestEval(t *testing.T) {
 		t.Fatal(a)
 	}
 
-	// XXX this probably should be unquoted?
-	if err := ui.Eval(`throw "fail"`).Err(); err.Error() != `"fail"` {
+	if err := ui.Eval(`throw "fail"`).Err(); err.Error() != `fail` {
 		t.Fatal(err)
 	}
 }
@@ -0,0 +1,148 @@
+package lorca
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Polling modes of WaitOptions
+const (
+	// PollingRAF checks the condition on every animation frame. Animation
+	// frames are not run while the page is hidden.
+	PollingRAF = "raf"
+	// PollingMutation checks the condition on every change of the DOM
+	PollingMutation = "mutation"
+)
+
+// WaitOptions tell how WaitForFunction and WaitForSelector check their
+// condition
+type WaitOptions struct {
+	// Polling is either PollingRAF, the default, or PollingMutation
+	Polling string
+	// Interval, if not zero, makes the condition to be checked periodically
+	// instead
+	Interval time.Duration
+	// Timeout, if not zero, is how long to wait for the condition. After that
+	// waiting fails with context.DeadlineExceeded.
+	Timeout time.Duration
+	// Visible makes WaitForSelector wait for the element to be visible, that
+	// is to have a non-empty bounding box and no visibility:hidden
+	Visible bool
+	// Hidden makes WaitForSelector wait for the element to be hidden or
+	// removed from the page
+	Hidden bool
+}
+
+// waitMargin is how long to wait for the page to report a timeout, before
+// giving up on it
+const waitMargin = 500 * time.Millisecond
+
+// waitScript calls predicate until it returns a truthy value
+const waitScript = `(predicate, polling, interval, timeout) => new Promise((resolve, reject) => {
+	let done = false, observer = null, timer = null, ticker = null;
+	const finish = (result, error) => {
+		done = true;
+		if (observer) observer.disconnect();
+		clearTimeout(timer);
+		clearInterval(ticker);
+		error ? reject(error) : resolve(result);
+	};
+	const check = () => {
+		if (done) return;
+		try {
+			const value = predicate();
+			if (value) {
+				finish({value});
+			} else if (polling === 'raf' && !interval) {
+				requestAnimationFrame(check);
+			}
+		} catch (e) {
+			finish(null, e);
+		}
+	};
+	if (timeout > 0) {
+		timer = setTimeout(() => finish({timedOut: true}), timeout);
+	}
+	if (interval > 0) {
+		ticker = setInterval(check, interval);
+	} else if (polling === 'mutation') {
+		observer = new MutationObserver(check);
+		observer.observe(document, {childList: true, subtree: true, attributes: true, characterData: true});
+	}
+	check();
+})`
+
+// selectorPredicate returns a predicate that checks for an element
+const selectorPredicate = `(selector, visible, hidden) => () => {
+	const el = document.querySelector(selector);
+	if (!visible && !hidden) {
+		return !!el;
+	}
+	const rect = el ? el.getBoundingClientRect() : null;
+	const shown = !!el && getComputedStyle(el).visibility !== 'hidden' && (rect.width > 0 || rect.height > 0);
+	return visible ? shown : !shown;
+}`
+
+// WaitForFunction waits until the JavaScript expression, which is evaluated
+// repeatedly, is truthy and returns its value. The expression must not return
+// a promise.
+func (c *Chrome) WaitForFunction(expr string, opts *WaitOptions) (json.RawMessage, error) {
+	predicate, err := c.EvalHandle("() => (" + expr + "\n)")
+	if err != nil {
+		return nil, err
+	}
+	defer predicate.Release()
+	return c.waitFor(predicate, opts)
+}
+
+// WaitForSelector waits until an element matching the CSS selector is in the
+// page, or is visible or hidden as set by opts
+func (c *Chrome) WaitForSelector(selector string, opts *WaitOptions) error {
+	if opts == nil {
+		opts = &WaitOptions{}
+	}
+	predicate, err := c.CallHandle(selectorPredicate, selector, opts.Visible, opts.Hidden)
+	if err != nil {
+		return err
+	}
+	defer predicate.Release()
+	_, err = c.waitFor(predicate, opts)
+	return err
+}
+
+func (c *Chrome) waitFor(predicate *JSHandle, opts *WaitOptions) (json.RawMessage, error) {
+	if opts == nil {
+		opts = &WaitOptions{}
+	}
+	polling := opts.Polling
+	if polling == "" {
+		polling = PollingRAF
+	} else if polling != PollingRAF && polling != PollingMutation {
+		return nil, fmt.Errorf("unknown polling mode %q", polling)
+	}
+	// The page enforces the timeout, but can't if it is stuck or blocked by
+	// a dialog, so it is enforced here too, a bit later
+	ctx := context.Background()
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout+waitMargin)
+		defer cancel()
+	}
+	raw, err := c.CallContext(ctx, waitScript, predicate, polling,
+		opts.Interval.Milliseconds(), opts.Timeout.Milliseconds())
+	if err != nil {
+		return nil, err
+	}
+	res := struct {
+		Value    json.RawMessage `json:"value"`
+		TimedOut bool            `json:"timedOut"`
+	}{}
+	if err := json.Unmarshal(raw, &res); err != nil {
+		return nil, err
+	} else if res.TimedOut {
+		return nil, context.DeadlineExceeded
+	}
+	return res.Value, nil
+}
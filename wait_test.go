@@ -0,0 +1,25 @@
+package lorca
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestWaitForStuckPage(t *testing.T) {
+	tr := &silentTransport{closed: make(chan struct{})}
+	defer close(tr.closed)
+	c := newChrome()
+	c.conn = tr
+	c.context = 1
+
+	// The page never answers, as if it was blocked by a dialog
+	predicate := &JSHandle{c: c, object: remoteObject{Type: "function", ObjectID: "1"}}
+	start := time.Now()
+	if _, err := c.waitFor(predicate, &WaitOptions{Timeout: 10 * time.Millisecond}); err != context.DeadlineExceeded {
+		t.Fatal(err)
+	}
+	if d := time.Since(start); d > 5*time.Second {
+		t.Fatal("timeout not enforced", d)
+	}
+}